@@ -1,10 +1,23 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"expvar"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/clients"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/blacklist"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/captcha"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/netpolicy"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/validator"
 	"github.com/gin-gonic/gin"
 )
 
@@ -18,14 +31,93 @@ func main() {
 
 	log.Print("Configurations loaded succesfully....")
 
+	// Background workers stop when the server is asked to shut down.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	blacklist.InitCache(ctx, config.RedisClient, &cfg)
+
+	if err := validator.InitPasswordPolicy(&cfg, config.RedisClient); err != nil {
+		log.Fatal("Failed to load password policy", err)
+	}
+
+	corsMiddleware, err := middleware.CORSMiddleware(&cfg)
+	if err != nil {
+		log.Fatal("Failed to load CORS policies", err)
+	}
+
+	limitsMiddleware, err := middleware.RequestLimitsMiddleware(&cfg)
+	if err != nil {
+		log.Fatal("Failed to load request limits", err)
+	}
+
+	networkPolicy, err := netpolicy.New(cfg.NETWORK_POLICIES, cfg.GEOIP_DB_PATH)
+	if err != nil {
+		log.Fatal("Failed to load network policies", err)
+	}
+	defer networkPolicy.Close()
+
+	verifier, err := captcha.New(&cfg)
+	if err != nil {
+		log.Fatal("Failed to configure captcha", err)
+	}
+
+	captchaMiddleware, err := middleware.CaptchaMiddleware(verifier, &cfg)
+	if err != nil {
+		log.Fatal("Invalid CAPTCHA_BYPASS_CIDRS", err)
+	}
+
 	router := gin.Default()
+	if err := router.SetTrustedProxies(config.GetList(cfg.TRUSTED_PROXIES)); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES", err)
+	}
+	router.TrustedPlatform = cfg.TRUSTED_PLATFORM
+
+	router.Use(middleware.NetworkPolicyMiddleware(networkPolicy))
+	router.Use(middleware.SecurityHeadersMiddleware(&cfg))
+	router.Use(corsMiddleware)
+	router.Use(limitsMiddleware)
+	router.Use(middleware.CSRFMiddleware())
+	router.Use(captchaMiddleware)
+	router.GET("/debug/vars", middleware.AdminAuthMiddleware(config.RedisClient), gin.WrapH(expvar.Handler()))
 
-	clients.RegisterAuthRoutes(router, &cfg)
-	clients.RegisterVendorRoutes(router, &cfg)
-	clients.RegisterAdminRoutes(router, &cfg)
-	clients.RegisterClientClient(router, &cfg)
+	backends := clients.DialBackends(&cfg)
+	defer backends.Close()
 
-	log.Print("Server start running on port:3000")
-	router.Run(":3000")
+	validator.Passwords.CheckCurrentWith(clients.CurrentPassword(backends))
 
+	clients.RegisterAuthRoutes(router, &cfg, backends)
+	clients.RegisterVendorRoutes(router, &cfg, backends)
+	clients.RegisterAdminRoutes(ctx, router, &cfg, backends)
+	clients.RegisterClientClient(router, &cfg, backends)
+	clients.RegisterAccountRoutes(router, &cfg, backends)
+	clients.RegisterPrivacyRoutes(ctx, router, &cfg, backends)
+	clients.RegisterWebhookRoutes(ctx, router, &cfg, backends)
+	clients.RegisterEscrowRoutes(ctx, router, &cfg, backends)
+
+	server := &http.Server{
+		Addr:              ":3000",
+		Handler:           router,
+		ReadHeaderTimeout: config.GetDuration(cfg.SERVER_READ_HEADER_TIMEOUT, 5*time.Second),
+		ReadTimeout:       config.GetDuration(cfg.SERVER_READ_TIMEOUT, 15*time.Second),
+		WriteTimeout:      config.GetDuration(cfg.SERVER_WRITE_TIMEOUT, 60*time.Second),
+		IdleTimeout:       config.GetDuration(cfg.SERVER_IDLE_TIMEOUT, 120*time.Second),
+	}
+
+	go func() {
+		log.Print("Server start running on port:3000")
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Server stopped", err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Print("Shutting down server....")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.GetDuration(cfg.SERVER_SHUTDOWN_TIMEOUT, 30*time.Second))
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Print("Server did not shut down cleanly: ", err)
+	}
 }
@@ -0,0 +1,68 @@
+package clients
+
+import (
+	"log"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/events"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/emailchange"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+type AccountClient struct {
+	Redis     *redis.Client
+	Cfg       *config.Config
+	Publisher emailchange.Publisher
+	Accounts  gwauth.AuthGatewayServiceClient
+}
+
+func RegisterAccountRoutes(eng *gin.Engine, cfg *config.Config, backends *Backends) *AccountClient {
+	ac := &AccountClient{
+		Redis:    config.RedisClient,
+		Cfg:      cfg,
+		Accounts: gwauth.NewAuthGatewayServiceClient(backends.Auth),
+	}
+
+	if cfg.RABBITMQ_URL != "" {
+		mq, err := events.NewRabbitMq(cfg.RABBITMQ_URL)
+		if err != nil {
+			log.Printf("Could not connect to RabbitMQ, email change is disabled: %v", err)
+		} else {
+			ac.Publisher = mq
+		}
+	}
+
+	routes := eng.Group("/me")
+	routes.Use(middleware.UserAuthMiddleware(config.RedisClient))
+	routes.GET("/sessions", ac.ListSessions)
+	routes.DELETE("/sessions/:id", ac.RevokeSession)
+	routes.POST("/sessions/revoke-all", ac.RevokeAllSessions)
+	routes.POST("/email", ac.RequestEmailChange)
+	routes.POST("/email/confirm", ac.ConfirmEmailChange)
+
+	return ac
+}
+
+func (ac *AccountClient) ListSessions(ctx *gin.Context) {
+	services.ListSessions(ctx, ac.Redis)
+}
+
+func (ac *AccountClient) RevokeSession(ctx *gin.Context) {
+	services.RevokeSession(ctx, ac.Redis)
+}
+
+func (ac *AccountClient) RevokeAllSessions(ctx *gin.Context) {
+	services.RevokeAllSessions(ctx, ac.Redis)
+}
+
+func (ac *AccountClient) RequestEmailChange(ctx *gin.Context) {
+	services.RequestEmailChange(ctx, ac.Publisher, ac.Accounts, ac.Redis)
+}
+
+func (ac *AccountClient) ConfirmEmailChange(ctx *gin.Context) {
+	services.ConfirmEmailChange(ctx, ac.Publisher, ac.Accounts, ac.Redis, ac.Cfg)
+}
@@ -1,49 +1,63 @@
 package clients
 
 import (
+	"context"
 	"log"
+	"time"
 
 	pb "github.com/AthulKrishna2501/proto-repo/admin"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/totp"
 	"github.com/gin-gonic/gin"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type AdminClient struct {
-	Client pb.AdminServiceClient
-	Cfg    config.Config
+	Client   pb.AdminServiceClient
+	Cfg      config.Config
+	TOTP     *totp.Store
+	Payments *payment.Registry
+	Refunds  *refund.GRPCBackend
+	Disputes *dispute.GRPCBackend
+	Accounts gwauth.AuthGatewayServiceClient
 }
 
-func InitAdminClient(c *config.Config) *AdminClient {
-	conn, err := grpc.NewClient(c.ADMIN_SVC_URL, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(
-		grpc.MaxCallRecvMsgSize(1024*1024*100),
-		grpc.MaxCallSendMsgSize(1024*1024*100),
-	))
-
-	if err != nil {
-		log.Fatal("Could not connect to admin client", err)
-	}
-
+func InitAdminClient(c *config.Config, backends *Backends) *AdminClient {
 	return &AdminClient{
-		Client: pb.NewAdminServiceClient(conn),
-		Cfg:    *c,
+		Client:   pb.NewAdminServiceClient(backends.Admin),
+		Cfg:      *c,
+		TOTP:     totp.NewStore(config.RedisClient, c.TOTP_ENCRYPTION_KEY),
+		Payments: payment.DefaultRegistry(c),
+		Refunds:  newRefundBackend(backends),
+		Disputes: newDisputeBackend(backends),
+		Accounts: gwauth.NewAuthGatewayServiceClient(backends.Auth),
 	}
 }
 
-func RegisterAdminRoutes(eng *gin.Engine, cfg *config.Config) *AdminClient {
-	ac := InitAdminClient(cfg)
+func RegisterAdminRoutes(ctx context.Context, eng *gin.Engine, cfg *config.Config, backends *Backends) *AdminClient {
+	ac := InitAdminClient(cfg, backends)
 	if ac.Client == nil {
 		log.Fatal("Admin Service Client is nil")
 	}
 
+	refund.NewRecordWorker(config.RedisClient, ac.Refunds, time.Minute).Start(ctx)
+
+	stepUp := middleware.StepUpMiddleware(config.RedisClient)
+
 	routes := eng.Group("/admin")
 	routes.Use(middleware.AdminAuthMiddleware(config.RedisClient))
+	routes.POST("/2fa/enroll", ac.EnrollTOTP)
+	routes.POST("/2fa/enroll/confirm", ac.ConfirmTOTPEnrollment)
+	routes.POST("/2fa/verify", ac.VerifyTOTP)
+	routes.DELETE("/2fa", stepUp, ac.DisableTOTP)
 	routes.POST("/approve-reject", ac.ApproveRejectCategory)
-	routes.PUT("/block-user", ac.BlockUser)
-	routes.PUT("/unblock-user", ac.UnblockUser)
+	routes.PUT("/block-user", stepUp, ac.BlockUser)
+	routes.PUT("/unblock-user", stepUp, ac.UnblockUser)
 	routes.GET("/users", ac.ListUsers)
 	routes.GET("/view-requests", ac.ViewCategoryRequests)
 	routes.GET("/list-category", ac.ListCategory)
@@ -52,7 +66,25 @@ func RegisterAdminRoutes(eng *gin.Engine, cfg *config.Config) *AdminClient {
 	routes.GET("/wallet", ac.GetAdminWallet)
 	routes.GET("/transactions", ac.GetAdminWalletTransactions)
 	routes.GET("/fund-release", ac.GetFundRelease)
-	routes.PUT("/fund-release", ac.ApproveFundRelease)
+	routes.PUT("/fund-release", stepUp, ac.ApproveFundRelease)
+	routes.POST("/api-keys", stepUp, ac.CreateAPIKey)
+	routes.GET("/api-keys", ac.ListAPIKeys)
+	routes.POST("/api-keys/:id/rotate", stepUp, ac.RotateAPIKey)
+	routes.DELETE("/api-keys/:id", ac.RevokeAPIKey)
+	routes.POST("/impersonate", stepUp, ac.StartImpersonation)
+	routes.DELETE("/impersonate/:id", ac.EndImpersonation)
+	routes.GET("/impersonate/audit", ac.GetImpersonationAudit)
+	routes.GET("/webhooks/failed", ac.ListFailedWebhooks)
+	routes.GET("/webhooks/events/:key", ac.GetWebhookEvent)
+	routes.POST("/webhooks/events/:key/replay", ac.ReplayWebhook)
+	routes.GET("/refunds", ac.ListRefunds)
+	routes.POST("/refunds/:id/approve", stepUp, ac.ApproveRefund)
+	routes.POST("/refunds/:id/reject", ac.RejectRefund)
+	routes.GET("/disputes", ac.ListDisputes)
+	routes.GET("/disputes/:id", ac.GetDispute)
+	routes.POST("/disputes/:id/review", ac.ReviewDispute)
+	routes.POST("/disputes/:id/resolve", stepUp, ac.ResolveDispute)
+	routes.POST("/disputes/:id/refund", stepUp, ac.RetryDisputeRefund)
 
 	return ac
 }
@@ -104,3 +136,92 @@ func (ac *AdminClient) GetFundRelease(ctx *gin.Context) {
 func (ac *AdminClient) ApproveFundRelease(ctx *gin.Context) {
 	services.ApproveFundRelease(ctx, ac.Client)
 }
+
+func (ac *AdminClient) EnrollTOTP(ctx *gin.Context) {
+	services.EnrollTOTP(ctx, ac.TOTP, ac.Cfg)
+}
+
+func (ac *AdminClient) ConfirmTOTPEnrollment(ctx *gin.Context) {
+	services.ConfirmTOTPEnrollment(ctx, ac.TOTP)
+}
+
+func (ac *AdminClient) VerifyTOTP(ctx *gin.Context) {
+	services.VerifyTOTP(ctx, ac.TOTP, ac.Cfg)
+}
+
+func (ac *AdminClient) DisableTOTP(ctx *gin.Context) {
+	services.DisableTOTP(ctx, ac.TOTP)
+}
+
+func (ac *AdminClient) CreateAPIKey(ctx *gin.Context) {
+	services.CreateAPIKey(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) ListAPIKeys(ctx *gin.Context) {
+	services.ListAPIKeys(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) RotateAPIKey(ctx *gin.Context) {
+	services.RotateAPIKey(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) RevokeAPIKey(ctx *gin.Context) {
+	services.RevokeAPIKey(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) StartImpersonation(ctx *gin.Context) {
+	ttl := config.GetDuration(ac.Cfg.IMPERSONATION_TTL, 15*time.Minute)
+	services.StartImpersonation(ctx, config.RedisClient, ttl, ac.Accounts)
+}
+
+func (ac *AdminClient) EndImpersonation(ctx *gin.Context) {
+	services.EndImpersonation(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) GetImpersonationAudit(ctx *gin.Context) {
+	services.GetImpersonationAudit(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) ListFailedWebhooks(ctx *gin.Context) {
+	services.ListFailedWebhooks(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) GetWebhookEvent(ctx *gin.Context) {
+	services.GetWebhookEvent(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) ReplayWebhook(ctx *gin.Context) {
+	services.ReplayWebhook(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) ListRefunds(ctx *gin.Context) {
+	services.ListRefunds(ctx, config.RedisClient)
+}
+
+func (ac *AdminClient) ApproveRefund(ctx *gin.Context) {
+	services.ApproveRefund(ctx, config.RedisClient, ac.Refunds, ac.Payments)
+}
+
+func (ac *AdminClient) RejectRefund(ctx *gin.Context) {
+	services.RejectRefund(ctx, config.RedisClient, ac.Refunds)
+}
+
+func (ac *AdminClient) ListDisputes(ctx *gin.Context) {
+	services.ListDisputes(ctx, config.RedisClient, dispute.RoleAdmin)
+}
+
+func (ac *AdminClient) GetDispute(ctx *gin.Context) {
+	services.GetDispute(ctx, config.RedisClient, dispute.RoleAdmin)
+}
+
+func (ac *AdminClient) ReviewDispute(ctx *gin.Context) {
+	services.ReviewDispute(ctx, config.RedisClient, ac.Disputes)
+}
+
+func (ac *AdminClient) ResolveDispute(ctx *gin.Context) {
+	services.ResolveDispute(ctx, config.RedisClient, ac.Disputes, ac.Refunds, ac.Payments)
+}
+
+func (ac *AdminClient) RetryDisputeRefund(ctx *gin.Context) {
+	services.RetryDisputeRefund(ctx, config.RedisClient, ac.Disputes, ac.Refunds, ac.Payments)
+}
@@ -1,34 +1,78 @@
 package clients
 
 import (
+	"context"
 	"log"
 
 	pb "github.com/AthulKrishna2501/proto-repo/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/oidc"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/passkey"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/validator"
 	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClient struct {
-	Client pb.AuthServiceClient
+	Client    pb.AuthServiceClient
+	Gateway   gwauth.AuthGatewayServiceClient
+	Cfg       *config.Config
+	Providers *oidc.Registry
+	Identity  oidc.IdentityExchanger
+	WebAuthn  *webauthn.WebAuthn
+	Passkeys  passkey.CredentialStore
+	Issuer    passkey.TokenIssuer
 }
 
-func InitServiceClient(c *config.Config) *ServiceClient {
-	conn, err := grpc.NewClient(c.AUTH_SVC_URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func InitServiceClient(c *config.Config, conn *grpc.ClientConn) *ServiceClient {
+	providers, err := oidc.NewRegistry(c)
+	if err != nil {
+		log.Fatal("Could not load OIDC providers", err)
+	}
 
+	wa, err := passkey.New(c)
 	if err != nil {
-		log.Fatal("Could not connect to auth client", err)
+		log.Fatal("Could not configure passkeys", err)
 	}
 
+	gateway := gwauth.NewAuthGatewayServiceClient(conn)
+
 	return &ServiceClient{
-		Client: pb.NewAuthServiceClient(conn),
+		Client:    pb.NewAuthServiceClient(conn),
+		Gateway:   gateway,
+		Cfg:       c,
+		Providers: providers,
+		Identity:  &oidc.GRPCExchanger{Client: gateway},
+		WebAuthn:  wa,
+		Passkeys:  passkey.NewRedisStore(config.RedisClient),
+		Issuer:    &passkey.GRPCIssuer{Client: gateway},
+	}
+}
+
+// CurrentPassword lets the password policy refuse a user's current password
+// as their new one, which the gateway's own history may not have seen.
+func CurrentPassword(backends *Backends) validator.CurrentPasswordFunc {
+	accounts := gwauth.NewAuthGatewayServiceClient(backends.Auth)
+
+	return func(ctx context.Context, userID, password string) (bool, error) {
+		res, err := accounts.IsCurrentPassword(ctx, &gwauth.IsCurrentPasswordRequest{
+			UserId:   userID,
+			Password: password,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return res.Current, nil
 	}
 }
 
-func RegisterAuthRoutes(eng *gin.Engine, cfg *config.Config) *ServiceClient {
-	svc := InitServiceClient(cfg)
+func RegisterAuthRoutes(eng *gin.Engine, cfg *config.Config, backends *Backends) *ServiceClient {
+	svc := InitServiceClient(cfg, backends.Auth)
 	if svc.Client == nil {
 		log.Fatal("Auth Service Client is nil!")
 	}
@@ -44,6 +88,19 @@ func RegisterAuthRoutes(eng *gin.Engine, cfg *config.Config) *ServiceClient {
 	routes.POST("/resend-otp", svc.ResendOTP)
 	routes.GET("/refresh-token", svc.RefreshToken)
 	routes.POST("/logout", svc.Logout)
+	routes.POST("/forgot-password", svc.ForgotPassword)
+	routes.POST("/forgot-password/verify", svc.VerifyPasswordResetOTP)
+	routes.POST("/reset-password", svc.ResetPassword)
+	routes.GET("/:provider/login", svc.OIDCLogin)
+	routes.GET("/:provider/callback", svc.OIDCCallback)
+	routes.POST("/:provider/callback", svc.OIDCCallback)
+	routes.POST("/passkey/login/begin", svc.BeginPasskeyLogin)
+	routes.POST("/passkey/login/finish", svc.FinishPasskeyLogin)
+
+	passkeyRoutes := routes.Group("/passkey/register")
+	passkeyRoutes.Use(middleware.UserAuthMiddleware(config.RedisClient))
+	passkeyRoutes.POST("/begin", svc.BeginPasskeyRegistration)
+	passkeyRoutes.POST("/finish", svc.FinishPasskeyRegistration)
 
 	return svc
 }
@@ -65,21 +122,57 @@ func (svc *ServiceClient) ResendOTP(ctx *gin.Context) {
 }
 
 func (svc *ServiceClient) Login(ctx *gin.Context) {
-	services.Login(ctx, svc.Client)
+	services.Login(ctx, svc.Client, config.RedisClient, svc.Cfg)
 }
 
 func (svc *ServiceClient) RefreshToken(ctx *gin.Context) {
-	services.RefreshToken(ctx, svc.Client)
+	services.RefreshToken(ctx, svc.Client, config.RedisClient, svc.Cfg)
 }
 
 func (svc *ServiceClient) Logout(ctx *gin.Context) {
-	services.Logout(ctx, svc.Client)
+	services.Logout(ctx, svc.Client, config.RedisClient, svc.Cfg)
+}
+
+func (svc *ServiceClient) ForgotPassword(ctx *gin.Context) {
+	services.ForgotPassword(ctx, svc.Gateway, config.RedisClient)
+}
+
+func (svc *ServiceClient) VerifyPasswordResetOTP(ctx *gin.Context) {
+	services.VerifyPasswordResetOTP(ctx, svc.Gateway, config.RedisClient)
+}
+
+func (svc *ServiceClient) ResetPassword(ctx *gin.Context) {
+	services.ResetForgottenPassword(ctx, svc.Gateway, config.RedisClient)
 }
 
 func (svc *ServiceClient) GoogleLogin(ctx *gin.Context) {
-	services.GoogleLogin(ctx, svc.Client)
+	services.GoogleLogin(ctx, svc.Client, config.RedisClient, svc.Cfg)
 }
 
 func (svc *ServiceClient) HandleGoogleCallback(ctx *gin.Context) {
-	services.HandleGoogleCallback(ctx, svc.Client)
+	services.HandleGoogleCallback(ctx, svc.Client, svc.Gateway, config.RedisClient, svc.Cfg)
+}
+
+func (svc *ServiceClient) OIDCLogin(ctx *gin.Context) {
+	services.OIDCLogin(ctx, svc.Providers, config.RedisClient, svc.Cfg)
+}
+
+func (svc *ServiceClient) OIDCCallback(ctx *gin.Context) {
+	services.OIDCCallback(ctx, svc.Providers, svc.Identity, config.RedisClient, svc.Cfg)
+}
+
+func (svc *ServiceClient) BeginPasskeyRegistration(ctx *gin.Context) {
+	services.BeginPasskeyRegistration(ctx, svc.WebAuthn, svc.Passkeys, config.RedisClient)
+}
+
+func (svc *ServiceClient) FinishPasskeyRegistration(ctx *gin.Context) {
+	services.FinishPasskeyRegistration(ctx, svc.WebAuthn, svc.Passkeys, config.RedisClient)
+}
+
+func (svc *ServiceClient) BeginPasskeyLogin(ctx *gin.Context) {
+	services.BeginPasskeyLogin(ctx, svc.WebAuthn, config.RedisClient)
+}
+
+func (svc *ServiceClient) FinishPasskeyLogin(ctx *gin.Context) {
+	services.FinishPasskeyLogin(ctx, svc.WebAuthn, svc.Passkeys, svc.Issuer, config.RedisClient, svc.Cfg)
 }
@@ -1,24 +1,38 @@
 package clients
 
 import (
+	"context"
 	"log"
 	"time"
 
 	pb "github.com/AthulKrishna2501/proto-repo/client"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/apikey"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
-	"github.com/gin-contrib/cors"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+	gwvendor "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/vendor"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
 	"github.com/gin-gonic/gin"
 	"github.com/sony/gobreaker"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ClientClient struct {
-	Client pb.ClientServiceClient
-	Cfg    *config.Config
-	CB     *gobreaker.CircuitBreaker
+	Client       pb.ClientServiceClient
+	Gateway      gwclient.ClientGatewayServiceClient
+	Conn         *grpc.ClientConn
+	Cfg          *config.Config
+	CB           *gobreaker.CircuitBreaker
+	Payments     *payment.Registry
+	Refunds      *refund.GRPCBackend
+	RefundPolicy *refund.Policy
+	Disputes     *dispute.GRPCBackend
+	Accounts     gwauth.AuthGatewayServiceClient
 }
 
 func newCircuitBreaker() *gobreaker.CircuitBreaker {
@@ -33,48 +47,64 @@ func newCircuitBreaker() *gobreaker.CircuitBreaker {
 	})
 }
 
-func InitClientClient(c *config.Config) *ClientClient {
-	conn, err := grpc.NewClient(c.CLIENT_SVC_URL, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(
-		grpc.MaxCallRecvMsgSize(1024*1024*100),
-		grpc.MaxCallSendMsgSize(1024*1024*100),
-	))
+func InitClientClient(c *config.Config, backends *Backends) *ClientClient {
+	conn := backends.Client
 
+	policy, err := refund.ParsePolicy(c.REFUND_POLICY)
 	if err != nil {
-		log.Fatal("Could not connect to client client", err)
+		log.Fatal("Could not load refund policy", err)
 	}
 
 	return &ClientClient{
-		Client: pb.NewClientServiceClient(conn),
-		CB:     newCircuitBreaker(),
-		Cfg:    c,
+		Client:       pb.NewClientServiceClient(conn),
+		Gateway:      gwclient.NewClientGatewayServiceClient(conn),
+		Conn:         conn,
+		CB:           newCircuitBreaker(),
+		Cfg:          c,
+		Payments:     payment.DefaultRegistry(c),
+		Refunds:      newRefundBackend(backends),
+		RefundPolicy: policy,
+		Disputes:     newDisputeBackend(backends),
+		Accounts:     gwauth.NewAuthGatewayServiceClient(backends.Auth),
 	}
 
 }
 
-func RegisterClientClient(eng *gin.Engine, cfg *config.Config) *ClientClient {
-	cc := InitClientClient(cfg)
+func newRefundBackend(backends *Backends) *refund.GRPCBackend {
+	return &refund.GRPCBackend{
+		Client: gwclient.NewClientGatewayServiceClient(backends.Client),
+		Admin:  gwadmin.NewAdminGatewayServiceClient(backends.Admin),
+	}
+}
+
+func newDisputeBackend(backends *Backends) *dispute.GRPCBackend {
+	return &dispute.GRPCBackend{
+		Client: gwclient.NewClientGatewayServiceClient(backends.Client),
+		Vendor: gwvendor.NewVendorGatewayServiceClient(backends.Vendor),
+		Admin:  gwadmin.NewAdminGatewayServiceClient(backends.Admin),
+	}
+}
+
+func RegisterClientClient(eng *gin.Engine, cfg *config.Config, backends *Backends) *ClientClient {
+	cc := InitClientClient(cfg, backends)
 
 	if cc.Client == nil {
 		log.Fatal("Client Service Client is nil")
 	}
 
-	eng.Use(cors.New(cors.Config{
-		AllowOrigins: []string{"http://localhost:3005"},
-		AllowMethods: []string{"GET", "POST"},
-		AllowHeaders: []string{"Origin", "Content-Type"},
-	}))
+	noImpersonation := middleware.NoImpersonation()
 
 	routes := eng.Group("/client")
 	routes.Use(middleware.ClientAuthMiddleware(config.RedisClient))
-	routes.POST("/mc/payment", cc.CreateBookingPayment)
+	routes.POST("/mc/payment", noImpersonation, cc.CreateBookingPayment)
 	routes.POST("/host-event", cc.HostEvent)
 	routes.PUT("/edit-event", cc.EditEvent)
 	routes.GET("/profile", cc.ClientProfile)
 	routes.PUT("/profile", cc.EditClientProfile)
-	routes.PUT("/reset-password", cc.ResetPassword)
+	routes.PUT("/reset-password", noImpersonation, cc.ResetPassword)
 	routes.GET("/bookings", cc.GetBookings)
 	routes.GET("/dashboard", cc.ClientDashboard)
-	routes.POST("/booking", cc.BookVendor)
+	routes.POST("/booking", noImpersonation, cc.BookVendor)
 	routes.GET("/vendors", cc.GetVendorsByCategory)
 	routes.GET("/hosted-events", cc.GetHostedEvents)
 	routes.GET("/upcoming-events", cc.GetUpcomingEvents)
@@ -85,13 +115,25 @@ func RegisterClientClient(eng *gin.Engine, cfg *config.Config) *ClientClient {
 	routes.GET("/review-ratings", cc.ViewClientReviewRatings)
 	routes.GET("/wallet", cc.GetClientWallet)
 	routes.GET("/transactions", cc.GetClientTransactions)
-	routes.POST("/complete-booking", cc.CompleteVendorBooking)
-	routes.POST("cancel-booking", cc.CancelVendorBooking)
-	routes.POST("/cancel-event", cc.CancelEvent)
+	routes.POST("/complete-booking", noImpersonation, cc.CompleteVendorBooking)
+	routes.POST("cancel-booking", noImpersonation, cc.CancelVendorBooking)
+	routes.POST("/cancel-event", noImpersonation, cc.CancelEvent)
 	routes.GET("/tickets", cc.GetTickets)
-	routes.POST("/fund-release", cc.FundRelease)
-
-	eng.POST("/webhook", cc.HandleStripeWebhook)
+	routes.POST("/fund-release", noImpersonation, cc.FundRelease)
+	routes.POST("/razorpay/verify", cc.VerifyRazorpayPayment)
+	routes.POST("/refunds", noImpersonation, cc.RequestRefund)
+	routes.GET("/refunds", cc.ListRefunds)
+	routes.POST("/disputes", noImpersonation, cc.OpenDispute)
+	routes.GET("/disputes", cc.ListDisputes)
+	routes.GET("/disputes/:id", cc.GetDispute)
+	routes.POST("/disputes/:id/evidence", cc.AddDisputeEvidence)
+	routes.POST("/disputes/:id/withdraw", noImpersonation, cc.WithdrawDispute)
+
+	clientAuth := middleware.ClientAuthMiddleware(config.RedisClient)
+	partner := eng.Group("/partner")
+	partner.GET("/upcoming-events", middleware.APIKeyMiddleware(config.RedisClient, apikey.ScopeEventsRead, clientAuth), cc.GetUpcomingEvents)
+	partner.GET("/vendors", middleware.APIKeyMiddleware(config.RedisClient, apikey.ScopeVendorsRead, clientAuth), cc.GetVendorsByCategory)
+	partner.GET("/vendor-profile", middleware.APIKeyMiddleware(config.RedisClient, apikey.ScopeVendorsRead, clientAuth), cc.GetVendorProfile)
 
 	return cc
 }
@@ -101,7 +143,7 @@ func (cc *ClientClient) CreateBookingPayment(ctx *gin.Context) {
 	log.Println("Circuit Breaker State (Before Call):", state)
 
 	_, err := cc.CB.Execute(func() (interface{}, error) {
-		services.CreateBookingPayment(ctx, cc.Client)
+		services.CreateBookingPayment(ctx, cc.Client, cc.Payments)
 		return nil, nil
 	})
 
@@ -114,9 +156,9 @@ func (cc *ClientClient) CreateBookingPayment(ctx *gin.Context) {
 	}
 }
 
-func (cc *ClientClient) HandleStripeWebhook(ctx *gin.Context) {
+func (cc *ClientClient) VerifyRazorpayPayment(ctx *gin.Context) {
 	_, err := cc.CB.Execute(func() (interface{}, error) {
-		services.HandleStripeWebhook(ctx, cc.Client, cc.Cfg)
+		services.VerifyRazorpayPayment(ctx, cc.Cfg.RAZORPAY_KEY_SECRET, cc.forwardPaymentEvent)
 		return nil, nil
 	})
 
@@ -126,6 +168,50 @@ func (cc *ClientClient) HandleStripeWebhook(ctx *gin.Context) {
 	}
 }
 
+// forwardPaymentEvent delivers the checkout confirmation straight to the
+// client service; the user is waiting on it, so it skips the webhook queue.
+func (cc *ClientClient) forwardPaymentEvent(ctx context.Context, event *payment.Event) error {
+	_, err := cc.Gateway.HandleRazorpayEvent(ctx, paymentEventRequest(event))
+	return err
+}
+
+func (cc *ClientClient) RequestRefund(ctx *gin.Context) {
+	_, err := cc.CB.Execute(func() (interface{}, error) {
+		limit := int64(config.GetInt(cc.Cfg.REFUND_AUTO_APPROVE_LIMIT, 0))
+		services.RequestRefund(ctx, config.RedisClient, cc.Refunds, cc.RefundPolicy, cc.Payments, limit)
+		return nil, nil
+	})
+
+	if err != nil {
+		ctx.JSON(503, gin.H{"error": "Payment Service Unavailable"})
+		return
+	}
+}
+
+func (cc *ClientClient) ListRefunds(ctx *gin.Context) {
+	services.ListClientRefunds(ctx, config.RedisClient)
+}
+
+func (cc *ClientClient) OpenDispute(ctx *gin.Context) {
+	services.OpenDispute(ctx, config.RedisClient, cc.Disputes)
+}
+
+func (cc *ClientClient) ListDisputes(ctx *gin.Context) {
+	services.ListDisputes(ctx, config.RedisClient, dispute.RoleClient)
+}
+
+func (cc *ClientClient) GetDispute(ctx *gin.Context) {
+	services.GetDispute(ctx, config.RedisClient, dispute.RoleClient)
+}
+
+func (cc *ClientClient) AddDisputeEvidence(ctx *gin.Context) {
+	services.AddDisputeEvidence(ctx, config.RedisClient, dispute.RoleClient)
+}
+
+func (cc *ClientClient) WithdrawDispute(ctx *gin.Context) {
+	services.WithdrawDispute(ctx, config.RedisClient, cc.Disputes)
+}
+
 func (cc *ClientClient) HostEvent(ctx *gin.Context) {
 	_, err := cc.CB.Execute(func() (interface{}, error) {
 		services.HostEvent(ctx, cc.Client)
@@ -183,7 +269,7 @@ func (cc *ClientClient) EditClientProfile(ctx *gin.Context) {
 
 func (cc *ClientClient) ResetPassword(ctx *gin.Context) {
 	_, err := cc.CB.Execute(func() (interface{}, error) {
-		services.ResetPassword(ctx, cc.Client)
+		services.ResetPassword(ctx, cc.Client, cc.Accounts)
 		return nil, nil
 
 	})
@@ -0,0 +1,72 @@
+package clients
+
+import (
+	"context"
+	"log"
+	"time"
+
+	clientpb "github.com/AthulKrishna2501/proto-repo/client"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/escrow"
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+	"github.com/gin-gonic/gin"
+)
+
+type EscrowClient struct {
+	Client  clientpb.ClientServiceClient
+	Backend *escrow.GRPCBackend
+	Rules   escrow.Rules
+}
+
+func RegisterEscrowRoutes(ctx context.Context, eng *gin.Engine, cfg *config.Config, backends *Backends) *EscrowClient {
+	rules, err := escrow.ParseRules(cfg.ESCROW_RULES)
+	if err != nil {
+		log.Fatal("Could not load escrow rules", err)
+	}
+
+	ec := &EscrowClient{
+		Client: clientpb.NewClientServiceClient(backends.Client),
+		Rules:  rules,
+	}
+	ec.Backend = &escrow.GRPCBackend{
+		Admin:        gwadmin.NewAdminGatewayServiceClient(backends.Admin),
+		Client:       gwclient.NewClientGatewayServiceClient(backends.Client),
+		ReleaseEvent: ec.releaseEvent,
+	}
+
+	interval := config.GetDuration(cfg.ESCROW_SCHEDULER_INTERVAL, 10*time.Minute)
+	escrow.NewScheduler(config.RedisClient, ec.Backend, rules, interval).Start(ctx)
+
+	routes := eng.Group("/admin/escrow")
+	routes.Use(middleware.AdminAuthMiddleware(config.RedisClient))
+	routes.GET("/rules", ec.GetRules)
+	routes.PUT("/rules", middleware.StepUpMiddleware(config.RedisClient), ec.UpdateRules)
+	routes.GET("/report", ec.Report)
+
+	return ec
+}
+
+// releaseEvent asks for an event's funds the same way the client's own
+// /client/fund-release call does.
+func (ec *EscrowClient) releaseEvent(ctx context.Context, clientID, eventID string) error {
+	_, err := ec.Client.RequestFundRelease(ctx, &clientpb.FundReleaseRequest{
+		ClientId: clientID,
+		EventId:  eventID,
+	})
+	return err
+}
+
+func (ec *EscrowClient) GetRules(ctx *gin.Context) {
+	services.GetEscrowRules(ctx, config.RedisClient, ec.Rules)
+}
+
+func (ec *EscrowClient) UpdateRules(ctx *gin.Context) {
+	services.UpdateEscrowRules(ctx, config.RedisClient)
+}
+
+func (ec *EscrowClient) Report(ctx *gin.Context) {
+	services.EscrowReport(ctx, config.RedisClient, ec.Backend, ec.Rules)
+}
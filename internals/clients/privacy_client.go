@@ -0,0 +1,197 @@
+package clients
+
+import (
+	"context"
+	"log"
+	"time"
+
+	clientpb "github.com/AthulKrishna2501/proto-repo/client"
+	vendorpb "github.com/AthulKrishna2501/proto-repo/vendor"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+	gwvendor "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/vendor"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/privacy"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/session"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+type PrivacyClient struct {
+	Redis         *redis.Client
+	Cfg           *config.Config
+	Auth          gwauth.AuthGatewayServiceClient
+	Admin         gwadmin.AdminGatewayServiceClient
+	Client        clientpb.ClientServiceClient
+	ClientGateway gwclient.ClientGatewayServiceClient
+	Vendor        vendorpb.VendorSeviceClient
+	VendorGateway gwvendor.VendorGatewayServiceClient
+	Grace         time.Duration
+}
+
+func RegisterPrivacyRoutes(ctx context.Context, eng *gin.Engine, cfg *config.Config, backends *Backends) *PrivacyClient {
+	pc := &PrivacyClient{
+		Redis:         config.RedisClient,
+		Cfg:           cfg,
+		Auth:          gwauth.NewAuthGatewayServiceClient(backends.Auth),
+		Admin:         gwadmin.NewAdminGatewayServiceClient(backends.Admin),
+		Client:        clientpb.NewClientServiceClient(backends.Client),
+		ClientGateway: gwclient.NewClientGatewayServiceClient(backends.Client),
+		Vendor:        vendorpb.NewVendorSeviceClient(backends.Vendor),
+		VendorGateway: gwvendor.NewVendorGatewayServiceClient(backends.Vendor),
+		Grace:         config.GetDuration(cfg.ACCOUNT_DELETION_GRACE, 14*24*time.Hour),
+	}
+
+	privacy.NewDeletionWorker(config.RedisClient, pc.deletionSteps(), time.Minute, pc.onDeleted).Start(ctx)
+
+	routes := eng.Group("/me")
+	routes.Use(middleware.UserAuthMiddleware(config.RedisClient))
+	routes.POST("/export", pc.RequestDataExport)
+	routes.GET("/export/:id", pc.GetDataExport)
+	routes.GET("/export/:id/download", pc.DownloadDataExport)
+	routes.DELETE("", pc.RequestAccountDeletion)
+	routes.GET("/deletion", pc.GetAccountDeletion)
+	routes.DELETE("/deletion", pc.CancelAccountDeletion)
+
+	adminRoutes := eng.Group("/admin/privacy")
+	adminRoutes.Use(middleware.AdminAuthMiddleware(config.RedisClient))
+	adminRoutes.GET("/deletions/failed", pc.ListFailedDeletions)
+	adminRoutes.POST("/deletions/:user_id/retry", pc.RetryAccountDeletion)
+	adminRoutes.DELETE("/deletions/:user_id", pc.AdminCancelAccountDeletion)
+
+	return pc
+}
+
+// deletionSteps runs auth last so the user can still be identified if an
+// earlier service fails and the deletion is retried.
+func (pc *PrivacyClient) deletionSteps() []privacy.Step {
+	return []privacy.Step{
+		{Service: "client", Roles: []string{"client"}, Run: func(ctx context.Context, req *privacy.DeletionRequest) error {
+			_, err := pc.ClientGateway.AnonymizeUser(ctx, &gwclient.AnonymizeUserRequest{UserId: req.UserID, Role: req.Role})
+			return err
+		}},
+		{Service: "vendor", Roles: []string{"vendor"}, Run: func(ctx context.Context, req *privacy.DeletionRequest) error {
+			_, err := pc.VendorGateway.AnonymizeUser(ctx, &gwvendor.AnonymizeUserRequest{UserId: req.UserID, Role: req.Role})
+			return err
+		}},
+		{Service: "admin", Run: func(ctx context.Context, req *privacy.DeletionRequest) error {
+			_, err := pc.Admin.AnonymizeUser(ctx, &gwadmin.AnonymizeUserRequest{UserId: req.UserID, Role: req.Role})
+			return err
+		}},
+		{Service: "auth", Run: func(ctx context.Context, req *privacy.DeletionRequest) error {
+			_, err := pc.Auth.AnonymizeUser(ctx, &gwauth.AnonymizeUserRequest{UserId: req.UserID, Role: req.Role})
+			return err
+		}},
+	}
+}
+
+func (pc *PrivacyClient) exportSections(userID, role string) []privacy.Section {
+	sections := []privacy.Section{
+		{Service: "auth", Name: "account", Fetch: func(ctx context.Context) (any, error) {
+			res, err := pc.Auth.ExportUserData(ctx, &gwauth.ExportUserDataRequest{UserId: userID, Role: role})
+			if err != nil {
+				return nil, err
+			}
+			return res.GetData().AsMap(), nil
+		}},
+		{Service: "admin", Name: "moderation", Fetch: func(ctx context.Context) (any, error) {
+			res, err := pc.Admin.ExportUserData(ctx, &gwadmin.ExportUserDataRequest{UserId: userID, Role: role})
+			if err != nil {
+				return nil, err
+			}
+			return res.GetData().AsMap(), nil
+		}},
+	}
+
+	switch role {
+	case "client":
+		sections = append(sections,
+			privacy.Section{Service: "client", Name: "profile", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.GetClientProfile(ctx, &clientpb.GetClientProfileRequest{ClientId: userID})
+			}},
+			privacy.Section{Service: "client", Name: "bookings", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.GetBookings(ctx, &clientpb.GetBookingsRequest{ClientId: userID})
+			}},
+			privacy.Section{Service: "client", Name: "events", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.GetHostedEvents(ctx, &clientpb.GetHostedEventsRequest{ClientId: userID})
+			}},
+			privacy.Section{Service: "client", Name: "tickets", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.GetBookedTickets(ctx, &clientpb.GetBookedTicketsRequest{ClientId: userID})
+			}},
+			privacy.Section{Service: "client", Name: "reviews", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.ViewClientReviewRatings(ctx, &clientpb.ViewClientReviewRatingsRequest{ClientId: userID})
+			}},
+			privacy.Section{Service: "client", Name: "wallet", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.GetWallet(ctx, &clientpb.GetWalletRequest{ClientId: userID})
+			}},
+			privacy.Section{Service: "client", Name: "transactions", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Client.GetClientTransactions(ctx, &clientpb.ViewClientTransactionsRequest{ClientId: userID})
+			}},
+		)
+	case "vendor":
+		sections = append(sections,
+			privacy.Section{Service: "vendor", Name: "profile", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Vendor.VendorProfile(ctx, &vendorpb.VendorProfileRequest{VendorId: userID})
+			}},
+			privacy.Section{Service: "vendor", Name: "services", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Vendor.GetVendorServices(ctx, &vendorpb.GetVendorServicesRequest{VendorId: userID})
+			}},
+			privacy.Section{Service: "vendor", Name: "bookings", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Vendor.GetBookingRequests(ctx, &vendorpb.GetBookingRequestsRequest{VendorId: userID})
+			}},
+			privacy.Section{Service: "vendor", Name: "wallet", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Vendor.GetVendorWallet(ctx, &vendorpb.GetVendorWalletRequest{VendorId: userID})
+			}},
+			privacy.Section{Service: "vendor", Name: "transactions", Fetch: func(ctx context.Context) (any, error) {
+				return pc.Vendor.GetVendorTransactions(ctx, &vendorpb.ViewVendorTransactionsRequest{VendorId: userID})
+			}},
+		)
+	}
+
+	return sections
+}
+
+func (pc *PrivacyClient) RequestDataExport(ctx *gin.Context) {
+	services.RequestDataExport(ctx, pc.exportSections, pc.Redis)
+}
+
+func (pc *PrivacyClient) GetDataExport(ctx *gin.Context) {
+	services.GetDataExport(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) DownloadDataExport(ctx *gin.Context) {
+	services.DownloadDataExport(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) RequestAccountDeletion(ctx *gin.Context) {
+	services.RequestAccountDeletion(ctx, pc.Auth, pc.Redis, pc.Grace)
+}
+
+func (pc *PrivacyClient) GetAccountDeletion(ctx *gin.Context) {
+	services.GetAccountDeletion(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) CancelAccountDeletion(ctx *gin.Context) {
+	services.CancelAccountDeletion(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) ListFailedDeletions(ctx *gin.Context) {
+	services.ListFailedDeletions(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) RetryAccountDeletion(ctx *gin.Context) {
+	services.RetryAccountDeletion(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) AdminCancelAccountDeletion(ctx *gin.Context) {
+	services.AdminCancelAccountDeletion(ctx, pc.Redis)
+}
+
+func (pc *PrivacyClient) onDeleted(ctx context.Context, req *privacy.DeletionRequest) {
+	if _, err := session.RevokeAll(ctx, pc.Redis, req.UserID); err != nil {
+		log.Printf("Failed to revoke sessions for deleted user %s: %v", req.UserID, err)
+	}
+}
@@ -0,0 +1,55 @@
+package clients
+
+import (
+	"log"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/grpctls"
+	"google.golang.org/grpc"
+)
+
+func transportCredentials(cfg *config.Config, service string) grpc.DialOption {
+	creds, err := grpctls.Credentials(grpctls.FromConfig(cfg, service), cfg.APP_ENV == "development")
+	if err != nil {
+		log.Fatalf("Could not configure transport security for %s service: %v", service, err)
+	}
+
+	return grpc.WithTransportCredentials(creds)
+}
+
+func dialService(cfg *config.Config, name, target string) *grpc.ClientConn {
+	conn, err := grpc.NewClient(target, transportCredentials(cfg, name), grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(1024*1024*100),
+		grpc.MaxCallSendMsgSize(1024*1024*100),
+	))
+
+	if err != nil {
+		log.Fatalf("Could not connect to %s service: %v", name, err)
+	}
+
+	return conn
+}
+
+// Backends holds the one connection each backend gets. Every route group
+// shares them.
+type Backends struct {
+	Auth   *grpc.ClientConn
+	Client *grpc.ClientConn
+	Vendor *grpc.ClientConn
+	Admin  *grpc.ClientConn
+}
+
+func DialBackends(cfg *config.Config) *Backends {
+	return &Backends{
+		Auth:   dialService(cfg, "auth", cfg.AUTH_SVC_URL),
+		Client: dialService(cfg, "client", cfg.CLIENT_SVC_URL),
+		Vendor: dialService(cfg, "vendor", cfg.VENDOR_SVC_URL),
+		Admin:  dialService(cfg, "admin", cfg.ADMIN_SVC_URL),
+	}
+}
+
+func (b *Backends) Close() {
+	for _, conn := range []*grpc.ClientConn{b.Auth, b.Client, b.Vendor, b.Admin} {
+		conn.Close()
+	}
+}
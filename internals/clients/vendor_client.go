@@ -7,38 +7,35 @@ import (
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/middleware"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
 	"github.com/gin-gonic/gin"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type VendorClient struct {
-	Client pb.VendorSeviceClient
+	Client   pb.VendorSeviceClient
+	Disputes *dispute.GRPCBackend
+	Accounts gwauth.AuthGatewayServiceClient
 }
 
-func InitVendorClient(c *config.Config) *VendorClient {
-	conn, err := grpc.NewClient(c.VENDOR_SVC_URL, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(
-		grpc.MaxCallRecvMsgSize(1024*1024*100),
-		grpc.MaxCallSendMsgSize(1024*1024*100),
-	))
-
-	if err != nil {
-		log.Fatal("Could not connect to vendor client", err)
-	}
-
+func InitVendorClient(c *config.Config, backends *Backends) *VendorClient {
 	return &VendorClient{
-		Client: pb.NewVendorSeviceClient(conn),
+		Client:   pb.NewVendorSeviceClient(backends.Vendor),
+		Disputes: newDisputeBackend(backends),
+		Accounts: gwauth.NewAuthGatewayServiceClient(backends.Auth),
 	}
 
 }
 
-func RegisterVendorRoutes(eng *gin.Engine, cfg *config.Config) *VendorClient {
-	vc := InitVendorClient(cfg)
+func RegisterVendorRoutes(eng *gin.Engine, cfg *config.Config, backends *Backends) *VendorClient {
+	vc := InitVendorClient(cfg, backends)
 
 	if vc.Client == nil {
 		log.Fatal("Vendor Service Client is nil")
 	}
 
+	noImpersonation := middleware.NoImpersonation()
+
 	routes := eng.Group("/vendor")
 	routes.Use(middleware.VendorAuthMiddleware(config.RedisClient))
 	routes.POST("/request-category", vc.RequestCategory)
@@ -48,12 +45,16 @@ func RegisterVendorRoutes(eng *gin.Engine, cfg *config.Config) *VendorClient {
 	routes.GET("/services", vc.GetServices)
 	routes.POST("/service", vc.CreateService)
 	routes.PUT("/service", vc.UpdateService)
-	routes.PATCH("/reset", vc.ResetPassword)
+	routes.PATCH("/reset", noImpersonation, vc.ResetPassword)
 	routes.GET("/dashboard", vc.VendorDashBoard)
 	routes.GET("/requests", vc.GetBookingRequests)
-	routes.POST("/approve-booking", vc.ApproveBooking)
+	routes.POST("/approve-booking", noImpersonation, vc.ApproveBooking)
 	routes.GET("/wallet", vc.GetVendorWallet)
 	routes.GET("/transactions", vc.GetVendorTransactions)
+	routes.GET("/disputes", vc.ListDisputes)
+	routes.GET("/disputes/:id", vc.GetDispute)
+	routes.POST("/disputes/:id/respond", noImpersonation, vc.RespondToDispute)
+	routes.POST("/disputes/:id/evidence", vc.AddDisputeEvidence)
 
 	return vc
 }
@@ -83,7 +84,7 @@ func (vc *VendorClient) UpdateService(ctx *gin.Context) {
 }
 
 func (vc *VendorClient) ResetPassword(ctx *gin.Context) {
-	services.ChangePassword(ctx, vc.Client)
+	services.ChangePassword(ctx, vc.Client, vc.Accounts)
 }
 
 func (vc *VendorClient) VendorDashBoard(ctx *gin.Context) {
@@ -110,3 +111,19 @@ func (vc *VendorClient) GetVendorTransactions(ctx *gin.Context) {
 	services.GetVendorTransactions(ctx, vc.Client)
 }
 
+func (vc *VendorClient) ListDisputes(ctx *gin.Context) {
+	services.ListDisputes(ctx, config.RedisClient, dispute.RoleVendor)
+}
+
+func (vc *VendorClient) GetDispute(ctx *gin.Context) {
+	services.GetDispute(ctx, config.RedisClient, dispute.RoleVendor)
+}
+
+func (vc *VendorClient) RespondToDispute(ctx *gin.Context) {
+	services.RespondToDispute(ctx, config.RedisClient, vc.Disputes)
+}
+
+func (vc *VendorClient) AddDisputeEvidence(ctx *gin.Context) {
+	services.AddDisputeEvidence(ctx, config.RedisClient, dispute.RoleVendor)
+}
+
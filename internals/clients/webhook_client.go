@@ -0,0 +1,148 @@
+package clients
+
+import (
+	"context"
+	"log"
+
+	pb "github.com/AthulKrishna2501/proto-repo/client"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/services"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+	gwvendor "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/vendor"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/webhooks"
+	"github.com/gin-gonic/gin"
+	"github.com/sony/gobreaker"
+)
+
+// Route methods name the typed RPC a backend handles the event with.
+const (
+	stripeEventHandler   = "HandleStripeEvent"
+	razorpayEventHandler = "HandleRazorpayEvent"
+)
+
+type eventHandler func(ctx context.Context, event *payment.Event) error
+
+type WebhookClient struct {
+	Cfg      *config.Config
+	Payments *payment.Registry
+	Router   *webhooks.Router
+	Handlers map[webhooks.Target]eventHandler
+	Breakers map[string]*gobreaker.CircuitBreaker
+}
+
+func defaultWebhookRoutes(cfg *config.Config) []webhooks.Route {
+	routes := []webhooks.Route{
+		{Provider: "stripe", Types: []string{"checkout.session.*", "payment_intent.*", "charge.*"}, Backend: webhooks.BackendClient, Method: stripeEventHandler},
+		{Provider: "stripe", Types: []string{"account.*", "capability.*", "person.*"}, Backend: webhooks.BackendVendor, Method: stripeEventHandler},
+		{Provider: "stripe", Types: []string{"payout.*"}, Backend: webhooks.BackendVendor, Method: stripeEventHandler},
+		{Provider: "stripe", Types: []string{"payout.*", "transfer.*", "balance.*"}, Backend: webhooks.BackendAdmin, Method: stripeEventHandler},
+		{Provider: "razorpay", Types: []string{"payment.*", "order.*", "refund.*"}, Backend: webhooks.BackendClient, Method: razorpayEventHandler},
+	}
+
+	// Fake events are Stripe-shaped, so the client service handles them
+	// like Stripe's.
+	if cfg.APP_ENV == "development" {
+		routes = append(routes, webhooks.Route{Provider: "fake", Types: []string{"*"}, Backend: webhooks.BackendClient, Method: stripeEventHandler})
+	}
+
+	return routes
+}
+
+func RegisterWebhookRoutes(ctx context.Context, eng *gin.Engine, cfg *config.Config, backends *Backends) *WebhookClient {
+	router, err := webhooks.NewRouter(cfg.WEBHOOK_ROUTES, defaultWebhookRoutes(cfg))
+	if err != nil {
+		log.Fatal("Could not load webhook routes", err)
+	}
+
+	wc := &WebhookClient{
+		Cfg:      cfg,
+		Payments: payment.DefaultRegistry(cfg),
+		Router:   router,
+		Handlers: webhookHandlers(backends),
+		Breakers: map[string]*gobreaker.CircuitBreaker{},
+	}
+	for _, backend := range []string{webhooks.BackendClient, webhooks.BackendVendor, webhooks.BackendAdmin} {
+		wc.Breakers[backend] = newCircuitBreaker()
+	}
+
+	for _, target := range router.Targets() {
+		if wc.Handlers[target] == nil {
+			log.Fatalf("Webhook route to the %s backend names unknown method %q, use %s or %s", target.Backend, target.Method, stripeEventHandler, razorpayEventHandler)
+		}
+	}
+
+	eng.POST("/webhook", wc.HandleStripeWebhook)
+	eng.POST("/webhook/:provider", wc.HandlePaymentWebhook)
+
+	webhooks.NewWorker(config.RedisClient, router, wc.forward).Start(ctx)
+
+	return wc
+}
+
+func webhookHandlers(backends *Backends) map[webhooks.Target]eventHandler {
+	client := pb.NewClientServiceClient(backends.Client)
+	clientGateway := gwclient.NewClientGatewayServiceClient(backends.Client)
+	vendor := gwvendor.NewVendorGatewayServiceClient(backends.Vendor)
+	admin := gwadmin.NewAdminGatewayServiceClient(backends.Admin)
+
+	return map[webhooks.Target]eventHandler{
+		{Backend: webhooks.BackendClient, Method: stripeEventHandler}: func(ctx context.Context, event *payment.Event) error {
+			_, err := client.HandleStripeEvent(ctx, &pb.StripeWebhookRequest{
+				EventType: event.Type,
+				Payload:   string(event.Payload),
+			})
+			return err
+		},
+		{Backend: webhooks.BackendClient, Method: razorpayEventHandler}: func(ctx context.Context, event *payment.Event) error {
+			_, err := clientGateway.HandleRazorpayEvent(ctx, paymentEventRequest(event))
+			return err
+		},
+		{Backend: webhooks.BackendVendor, Method: stripeEventHandler}: func(ctx context.Context, event *payment.Event) error {
+			_, err := vendor.HandleStripeEvent(ctx, &gwvendor.PaymentEventRequest{
+				Provider:  event.Provider,
+				EventId:   event.ID,
+				EventType: event.Type,
+				Payload:   event.Payload,
+			})
+			return err
+		},
+		{Backend: webhooks.BackendAdmin, Method: stripeEventHandler}: func(ctx context.Context, event *payment.Event) error {
+			_, err := admin.HandleStripeEvent(ctx, &gwadmin.PaymentEventRequest{
+				Provider:  event.Provider,
+				EventId:   event.ID,
+				EventType: event.Type,
+				Payload:   event.Payload,
+			})
+			return err
+		},
+	}
+}
+
+func (wc *WebhookClient) HandleStripeWebhook(ctx *gin.Context) {
+	services.HandlePaymentWebhook(ctx, wc.Payments, wc.Router, "stripe", config.RedisClient)
+}
+
+func (wc *WebhookClient) HandlePaymentWebhook(ctx *gin.Context) {
+	services.HandlePaymentWebhook(ctx, wc.Payments, wc.Router, ctx.Param("provider"), config.RedisClient)
+}
+
+// forward sends an event to whichever backend the route names, behind that
+// backend's circuit breaker.
+func (wc *WebhookClient) forward(ctx context.Context, target webhooks.Target, event *payment.Event) error {
+	_, err := wc.Breakers[target.Backend].Execute(func() (interface{}, error) {
+		return nil, wc.Handlers[target](ctx, event)
+	})
+
+	return err
+}
+
+func paymentEventRequest(event *payment.Event) *gwclient.PaymentEventRequest {
+	return &gwclient.PaymentEventRequest{
+		Provider:  event.Provider,
+		EventId:   event.ID,
+		EventType: event.Type,
+		Payload:   event.Payload,
+	}
+}
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"encoding/json"
 	"log"
 
 	"github.com/rabbitmq/amqp091-go"
@@ -36,6 +37,19 @@ func NewRabbitMq(url string) (*RabbitMq, error) {
 		return nil, err
 	}
 
+	_, err = ch.QueueDeclare(
+		"email_notification_queue",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &RabbitMq{Conn: conn, Channel: ch}, nil
 }
 
@@ -56,9 +70,32 @@ func (r *RabbitMq) PublishOTP(email, otp string) error {
 		return err
 	}
 
-	log.Println("Published OTP event: ", body)
+	log.Println("Published OTP event for: ", email)
 	return nil
 }
+
+func (r *RabbitMq) PublishEmailChanged(oldEmail, newEmail string) error {
+	body, err := json.Marshal(map[string]string{
+		"type":      "email_changed",
+		"email":     oldEmail,
+		"new_email": newEmail,
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.Channel.Publish(
+		"",
+		"email_notification_queue",
+		false,
+		false,
+		amqp091.Publishing{
+			ContentType: "application/json",
+			Body:        body,
+		},
+	)
+}
+
 func (r *RabbitMq) Close() {
 	r.Channel.Close()
 	r.Conn.Close()
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/apikey"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyMiddleware authenticates partners by the X-API-Key header. When the
+// header is absent the request is handed to fallback, so a route can accept
+// either an API key or a user JWT.
+func APIKeyMiddleware(redisClient *redis.Client, scope string, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw := c.GetHeader(APIKeyHeader)
+		if raw == "" {
+			if fallback != nil {
+				fallback(c)
+				return
+			}
+
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "API key required"})
+			c.Abort()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		key, err := apikey.Authenticate(ctx, redisClient, raw)
+		switch {
+		case errors.Is(err, apikey.ErrInvalidKey), errors.Is(err, apikey.ErrKeyRevoked), errors.Is(err, apikey.ErrKeyExpired):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		case err != nil:
+			log.Println("Error checking API key:", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error while checking API key"})
+			c.Abort()
+			return
+		}
+
+		if !key.HasScope(scope) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "API key is missing the required scope", "scope": scope})
+			c.Abort()
+			return
+		}
+
+		allowed, remaining, err := apikey.Allow(ctx, redisClient, key)
+		if err != nil {
+			log.Println("Error applying API key rate limit:", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error while checking API key"})
+			c.Abort()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(key.RateLimit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(60-time.Now().Second()))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "API key rate limit exceeded"})
+			c.Abort()
+			return
+		}
+
+		c.Set("api_key_id", key.ID)
+		c.Next()
+	}
+}
@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/blacklist"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/cookies"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/session"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+func bearerToken(c *gin.Context) (string, bool) {
+	tokenString := c.GetHeader("Authorization")
+	if tokenString == "" {
+		if cookieToken, ok := cookies.AccessToken(c); ok {
+			c.Set("cookie_auth", true)
+			return cookieToken, true
+		}
+
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token required"})
+		c.Abort()
+		return "", false
+	}
+
+	tokenParts := strings.Split(tokenString, " ")
+	if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token format"})
+		c.Abort()
+		return "", false
+	}
+
+	return tokenParts[1], true
+}
+
+func authenticate(c *gin.Context, redisClient *redis.Client, secret []byte) (jwt.MapClaims, bool) {
+	tokenString, ok := bearerToken(c)
+	if !ok {
+		return nil, false
+	}
+
+	ctx := context.Background()
+	sessionID, err := session.Resolve(ctx, redisClient, tokenString)
+	if err != nil {
+		log.Println("Error resolving session:", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Unable to verify session. Please try again shortly."})
+		c.Abort()
+		return nil, false
+	}
+
+	isBlacklisted, err := blacklist.IsRevoked(ctx, redisClient, "blacklist:"+tokenString, session.RevokedKey(session.ID(tokenString)), session.RevokedKey(sessionID))
+	if errors.Is(err, blacklist.ErrUnavailable) {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Unable to verify session. Please try again shortly."})
+		c.Abort()
+		return nil, false
+	}
+	if err != nil {
+		log.Println("Error checking Redis:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error while checking token"})
+		c.Abort()
+		return nil, false
+	}
+	if isBlacklisted {
+		log.Printf("Rejected revoked token for session %s", sessionID)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Session expired. Please log in again."})
+		c.Abort()
+		return nil, false
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("invalid signing method")
+		}
+		return secret, nil
+	})
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		c.Abort()
+		return nil, false
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+		c.Abort()
+		return nil, false
+	}
+
+	if !impersonator(c, redisClient, claims) {
+		return nil, false
+	}
+
+	c.Set("session_id", sessionID)
+	return claims, true
+}
+
+func trackSession(c *gin.Context, redisClient *redis.Client, claims jwt.MapClaims) {
+	userID, _ := claims["user_id"].(string)
+	role, _ := claims["role"].(string)
+	sessionID := c.GetString("session_id")
+	if c.GetString("impersonator_id") != "" || !session.ShouldTouch(sessionID) {
+		return
+	}
+
+	var expiresAt time.Time
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		expiresAt = exp.Time
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := session.Touch(ctx, redisClient, sessionID, userID, role, c.ClientIP(), c.Request.UserAgent(), expiresAt)
+	if err != nil {
+		log.Printf("Failed to track session %s: %v", sessionID, err)
+	}
+}
+
+func UserAuthMiddleware(redisClient *redis.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := authenticate(c, redisClient, jwtSecret)
+		if !ok {
+			return
+		}
+
+		if rejectImpersonation(c) {
+			return
+		}
+
+		role, ok := claims["role"].(string)
+		if !ok || (role != "client" && role != "vendor" && role != "admin") {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Access denied: Invalid role"})
+			c.Abort()
+			return
+		}
+
+		trackSession(c, redisClient, claims)
+
+		c.Set("user_id", claims["user_id"])
+		c.Set("role", role)
+		c.Next()
+	}
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/cookies"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	defaultCORSOrigins = []string{"http://localhost:3005"}
+	defaultCORSMethods = []string{
+		http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions,
+	}
+	defaultCORSHeaders = []string{
+		"Origin", "Content-Type", "Authorization", cookies.CSRFTokenHeader, APIKeyHeader, CaptchaTokenHeader,
+	}
+	defaultCORSExpose = []string{
+		cookies.CSRFTokenHeader, "Content-Disposition", "Retry-After", "X-RateLimit-Limit", "X-RateLimit-Remaining",
+	}
+)
+
+// CORSPolicy overrides the default CORS settings for every path under
+// Prefix. Unset fields inherit the default policy.
+type CORSPolicy struct {
+	Prefix           string   `json:"prefix"`
+	AllowOrigins     []string `json:"allow_origins"`
+	AllowMethods     []string `json:"allow_methods"`
+	AllowHeaders     []string `json:"allow_headers"`
+	ExposeHeaders    []string `json:"expose_headers"`
+	AllowCredentials *bool    `json:"allow_credentials"`
+	MaxAge           string   `json:"max_age"`
+}
+
+type corsRoute struct {
+	prefix  string
+	handler gin.HandlerFunc
+}
+
+// CORSMiddleware has to run before any route group so that preflight
+// requests are answered for every method, not only the ones a group
+// registers.
+func CORSMiddleware(cfg *config.Config) (gin.HandlerFunc, error) {
+	base := CORSPolicy{
+		AllowOrigins:     config.GetList(cfg.CORS_ALLOW_ORIGINS),
+		AllowMethods:     config.GetList(cfg.CORS_ALLOW_METHODS),
+		AllowHeaders:     config.GetList(cfg.CORS_ALLOW_HEADERS),
+		ExposeHeaders:    config.GetList(cfg.CORS_EXPOSE_HEADERS),
+		AllowCredentials: boolPtr(config.GetBool(cfg.CORS_ALLOW_CREDENTIALS, true)),
+		MaxAge:           cfg.CORS_MAX_AGE,
+	}
+	if len(base.AllowOrigins) == 0 {
+		base.AllowOrigins = defaultCORSOrigins
+	}
+	if len(base.AllowMethods) == 0 {
+		base.AllowMethods = defaultCORSMethods
+	}
+	if len(base.AllowHeaders) == 0 {
+		base.AllowHeaders = defaultCORSHeaders
+	}
+	if len(base.ExposeHeaders) == 0 {
+		base.ExposeHeaders = defaultCORSExpose
+	}
+
+	var policies []CORSPolicy
+	if strings.TrimSpace(cfg.CORS_ROUTE_POLICIES) != "" {
+		if err := json.Unmarshal([]byte(cfg.CORS_ROUTE_POLICIES), &policies); err != nil {
+			return nil, fmt.Errorf("invalid CORS_ROUTE_POLICIES: %w", err)
+		}
+	}
+
+	fallback, err := corsHandler(base)
+	if err != nil {
+		return nil, fmt.Errorf("default CORS policy: %w", err)
+	}
+
+	routes := make([]corsRoute, 0, len(policies))
+	for _, policy := range policies {
+		if !strings.HasPrefix(policy.Prefix, "/") {
+			return nil, fmt.Errorf("CORS policy prefix %q must start with /", policy.Prefix)
+		}
+
+		handler, err := corsHandler(policy.inherit(base))
+		if err != nil {
+			return nil, fmt.Errorf("CORS policy for %s: %w", policy.Prefix, err)
+		}
+		routes = append(routes, corsRoute{prefix: strings.TrimSuffix(policy.Prefix, "/"), handler: handler})
+	}
+
+	// Longest prefix wins, so /client/partner can differ from /client.
+	sort.Slice(routes, func(i, j int) bool {
+		return len(routes[i].prefix) > len(routes[j].prefix)
+	})
+
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		for _, route := range routes {
+			if path == route.prefix || strings.HasPrefix(path, route.prefix+"/") {
+				route.handler(c)
+				return
+			}
+		}
+
+		fallback(c)
+	}, nil
+}
+
+func (p CORSPolicy) inherit(base CORSPolicy) CORSPolicy {
+	if len(p.AllowOrigins) == 0 {
+		p.AllowOrigins = base.AllowOrigins
+	}
+	if len(p.AllowMethods) == 0 {
+		p.AllowMethods = base.AllowMethods
+	}
+	if len(p.AllowHeaders) == 0 {
+		p.AllowHeaders = base.AllowHeaders
+	}
+	if len(p.ExposeHeaders) == 0 {
+		p.ExposeHeaders = base.ExposeHeaders
+	}
+	if p.AllowCredentials == nil {
+		p.AllowCredentials = base.AllowCredentials
+	}
+	if p.MaxAge == "" {
+		p.MaxAge = base.MaxAge
+	}
+
+	return p
+}
+
+func corsHandler(p CORSPolicy) (gin.HandlerFunc, error) {
+	corsCfg := cors.Config{
+		AllowMethods:     p.AllowMethods,
+		AllowHeaders:     p.AllowHeaders,
+		ExposeHeaders:    p.ExposeHeaders,
+		AllowCredentials: p.AllowCredentials != nil && *p.AllowCredentials,
+		AllowWildcard:    true,
+		MaxAge:           config.GetDuration(p.MaxAge, 12*time.Hour),
+	}
+
+	if len(p.AllowOrigins) == 1 && p.AllowOrigins[0] == "*" {
+		if corsCfg.AllowCredentials {
+			return nil, fmt.Errorf("allow_credentials cannot be combined with a * origin")
+		}
+		corsCfg.AllowAllOrigins = true
+	} else {
+		for _, origin := range p.AllowOrigins {
+			if strings.Count(origin, "*") > 1 {
+				return nil, fmt.Errorf("origin %q may contain at most one *", origin)
+			}
+		}
+		corsCfg.AllowOrigins = p.AllowOrigins
+	}
+
+	if err := corsCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cors.New(corsCfg), nil
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/cookies"
+	"github.com/gin-gonic/gin"
+)
+
+func CSRFMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		if c.GetHeader("Authorization") != "" {
+			c.Next()
+			return
+		}
+
+		if _, ok := cookies.AccessToken(c); !ok {
+			c.Next()
+			return
+		}
+
+		if !cookies.ValidCSRF(c) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid or missing CSRF token"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/netpolicy"
+	"github.com/gin-gonic/gin"
+)
+
+// NetworkPolicyMiddleware relies on gin's ClientIP, so the engine's trusted
+// proxies must be configured for forwarded addresses to be honoured.
+func NetworkPolicyMiddleware(policy *netpolicy.Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientIP := c.ClientIP()
+
+		allowed, reason := policy.Check(c.Request.URL.Path, net.ParseIP(clientIP))
+		if !allowed {
+			log.Printf("Network policy rejected %s %s from %s: %s", c.Request.Method, c.Request.URL.Path, clientIP, reason)
+			c.JSON(http.StatusForbidden, gin.H{"error": "Access denied from this network"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
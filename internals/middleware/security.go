@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultMaxBodySize   = 1 << 20
+	defaultJSONMaxDepth  = 32
+	defaultJSONMaxFields = 1000
+
+	// Image uploads are sent inline as base64, which adds a third to the
+	// size of the file.
+	defaultUploadBodySize = 16 << 20
+)
+
+var defaultContentTypes = []string{"application/json", "application/x-www-form-urlencoded"}
+
+// defaultRouteLimits covers the routes carrying poster_image or
+// profile_image. MAX_BODY_SIZE_ROUTES entries override them.
+var defaultRouteLimits = map[string]int64{
+	"/client/host-event": defaultUploadBodySize,
+	"/client/edit-event": defaultUploadBodySize,
+	"/client/profile":    defaultUploadBodySize,
+	"/vendor/me":         defaultUploadBodySize,
+}
+
+func SecurityHeadersMiddleware(cfg *config.Config) gin.HandlerFunc {
+	headers := map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	}
+
+	if hsts := config.GetDuration(cfg.HSTS_MAX_AGE, 365*24*time.Hour); hsts > 0 {
+		value := "max-age=" + strconv.Itoa(int(hsts.Seconds()))
+		if config.GetBool(cfg.HSTS_INCLUDE_SUBDOMAINS, true) {
+			value += "; includeSubDomains"
+		}
+		if config.GetBool(cfg.HSTS_PRELOAD, false) {
+			value += "; preload"
+		}
+		headers["Strict-Transport-Security"] = value
+	}
+
+	optional := map[string]struct{ value, fallback string }{
+		"Content-Security-Policy": {cfg.CONTENT_SECURITY_POLICY, "default-src 'none'; frame-ancestors 'none'"},
+		"Referrer-Policy":         {cfg.REFERRER_POLICY, "no-referrer"},
+		"X-Frame-Options":         {cfg.FRAME_OPTIONS, "DENY"},
+	}
+	for name, header := range optional {
+		switch value := strings.TrimSpace(header.value); value {
+		case "":
+			headers[name] = header.fallback
+		case "off":
+		default:
+			headers[name] = value
+		}
+	}
+
+	return func(c *gin.Context) {
+		for name, value := range headers {
+			c.Header(name, value)
+		}
+
+		c.Next()
+	}
+}
+
+type bodyLimit struct {
+	prefix string
+	size   int64
+}
+
+// RequestLimitsMiddleware caps request bodies before they reach a handler
+// and, through it, the 100MB gRPC limits of the backends.
+func RequestLimitsMiddleware(cfg *config.Config) (gin.HandlerFunc, error) {
+	defaultSize := int64(config.GetInt(cfg.MAX_BODY_SIZE, defaultMaxBodySize))
+	maxDepth := config.GetInt(cfg.JSON_MAX_DEPTH, defaultJSONMaxDepth)
+	maxFields := config.GetInt(cfg.JSON_MAX_FIELDS, defaultJSONMaxFields)
+
+	contentTypes := config.GetList(strings.ToLower(cfg.ALLOWED_CONTENT_TYPES))
+	if len(contentTypes) == 0 {
+		contentTypes = defaultContentTypes
+	}
+
+	routes := map[string]int64{}
+	for prefix, size := range defaultRouteLimits {
+		routes[prefix] = size
+	}
+	if strings.TrimSpace(cfg.MAX_BODY_SIZE_ROUTES) != "" {
+		var configured map[string]int64
+		if err := json.Unmarshal([]byte(cfg.MAX_BODY_SIZE_ROUTES), &configured); err != nil {
+			return nil, fmt.Errorf("invalid MAX_BODY_SIZE_ROUTES: %w", err)
+		}
+		for prefix, size := range configured {
+			routes[strings.TrimSuffix(prefix, "/")] = size
+		}
+	}
+
+	var limits []bodyLimit
+	for prefix, size := range routes {
+		limits = append(limits, bodyLimit{prefix: prefix, size: size})
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		return len(limits[i].prefix) > len(limits[j].prefix)
+	})
+
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody || c.Request.ContentLength == 0 {
+			c.Next()
+			return
+		}
+
+		size := defaultSize
+		for _, limit := range limits {
+			if c.Request.URL.Path == limit.prefix || strings.HasPrefix(c.Request.URL.Path, limit.prefix+"/") {
+				size = limit.size
+				break
+			}
+		}
+
+		if c.Request.ContentLength > size {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Request body is too large"})
+			c.Abort()
+			return
+		}
+
+		mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+		if err != nil || !allowedContentType(mediaType, contentTypes) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Unsupported content type"})
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, size))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Request body is too large"})
+			} else {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+			}
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		if mediaType == "application/json" {
+			if err := checkJSONShape(body, maxDepth, maxFields); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}, nil
+}
+
+func allowedContentType(mediaType string, allowed []string) bool {
+	for _, contentType := range allowed {
+		if mediaType == contentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkJSONShape walks the tokens without building the value, so deeply
+// nested or very wide payloads are rejected before gin binds them.
+func checkJSONShape(body []byte, maxDepth, maxFields int) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	depth, fields := 0, 0
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) && depth == 0 {
+			return nil
+		}
+		if err != nil {
+			return errors.New("Malformed JSON body")
+		}
+
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+			if depth > maxDepth {
+				return errors.New("JSON body is nested too deeply")
+			}
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		default:
+			fields++
+			if fields > maxFields {
+				return errors.New("JSON body has too many fields")
+			}
+		}
+	}
+}
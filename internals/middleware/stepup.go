@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/totp"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func StepUpMiddleware(redisClient *redis.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID := c.GetString("session_id")
+		if sessionID == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization token required"})
+			c.Abort()
+			return
+		}
+
+		verified, err := redisClient.Exists(context.Background(), totp.StepUpKey(sessionID)).Result()
+		if err != nil {
+			fmt.Println("Error checking Redis:", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Server error while checking verification"})
+			c.Abort()
+			return
+		}
+
+		if verified == 0 {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":            "Two-factor verification required for this action",
+				"step_up_required": true,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -14,3 +14,38 @@ type ApproveFundReleaseRequest struct {
 	RequestID string `json:"request_id"`
 	Status    string `json:"status"`
 }
+
+type TOTPCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name" binding:"required"`
+	Scopes        []string `json:"scopes" binding:"required"`
+	RateLimit     int      `json:"rate_limit"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+type ImpersonateRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	Role   string `json:"role" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+type RejectRefundRequest struct {
+	Note string `json:"note" binding:"required"`
+}
+
+type ResolveDisputeRequest struct {
+	Decision     string `json:"decision" binding:"required,oneof=refund release"`
+	RefundAmount int64  `json:"refund_amount" binding:"gte=0"`
+	Note         string `json:"note" binding:"required"`
+}
+
+type EscrowRulesRequest struct {
+	Enabled          bool     `json:"enabled"`
+	EventDelayDays   int      `json:"event_delay_days" binding:"gte=0,lte=365"`
+	BookingDelayDays int      `json:"booking_delay_days" binding:"gte=0,lte=365"`
+	MaxAmount        int64    `json:"max_amount" binding:"gte=0"`
+	ExcludedVendors  []string `json:"excluded_vendors"`
+}
@@ -27,6 +27,30 @@ type TokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
 type LogoutRequest struct {
 	AccessToken string `json:"access_token"`
 }
+
+type PasswordResetRequest struct {
+	ResetToken      string `json:"reset_token" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+	ConfirmPassword string `json:"confirm_password" binding:"required"`
+}
+
+type EmailChangeRequest struct {
+	NewEmail        string `json:"new_email" binding:"required"`
+	CurrentPassword string `json:"current_password"`
+}
+
+type EmailChangeConfirmRequest struct {
+	OTP string `json:"otp" binding:"required"`
+}
+
+type AccountDeletionRequest struct {
+	CurrentPassword string `json:"current_password"`
+}
@@ -95,3 +95,27 @@ type CancelEventRequest struct {
 type FundReleaseRequest struct {
 	EventID string `json:"event_id"`
 }
+
+type RazorpayVerifyRequest struct {
+	OrderID   string `json:"razorpay_order_id" binding:"required"`
+	PaymentID string `json:"razorpay_payment_id" binding:"required"`
+	Signature string `json:"razorpay_signature" binding:"required"`
+}
+
+type RefundRequest struct {
+	Kind        string `json:"kind" binding:"required,oneof=booking ticket"`
+	ReferenceID string `json:"reference_id" binding:"required"`
+	Reason      string `json:"reason"`
+}
+
+type OpenDisputeRequest struct {
+	BookingID   string   `json:"booking_id" binding:"required"`
+	Reason      string   `json:"reason" binding:"required"`
+	Description string   `json:"description"`
+	Attachments []string `json:"attachments" binding:"max=10,dive,url"`
+}
+
+type DisputeEvidenceRequest struct {
+	Description string   `json:"description" binding:"required"`
+	Attachments []string `json:"attachments" binding:"max=10,dive,url"`
+}
@@ -48,3 +48,8 @@ type ChangePasswordRequest struct {
 	NewPassword     string `json:"new_password" binding:"required"`
 	ConfirmPassword string `json:"confirm_password" binding:"required"`
 }
+
+type DisputeResponseRequest struct {
+	Response    string   `json:"response" binding:"required"`
+	Attachments []string `json:"attachments" binding:"max=10,dive,url"`
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/apikey"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func CreateAPIKey(ctx *gin.Context, rdb *redis.Client) {
+	var req models.CreateAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Name and scopes are required"})
+		return
+	}
+
+	if len(req.Scopes) == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "At least one scope is required", "available_scopes": apikey.Scopes})
+		return
+	}
+
+	if req.RateLimit < 0 || req.ExpiresInDays < 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "rate_limit and expires_in_days must not be negative"})
+		return
+	}
+
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	ttl := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+
+	raw, key, err := apikey.Create(ctx, rdb, req.Name, req.Scopes, req.RateLimit, ttl, adminID)
+	if errors.Is(err, apikey.ErrUnknownScope) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "available_scopes": apikey.Scopes})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create API key", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Store this key securely, it will not be shown again",
+		"data": gin.H{
+			"api_key": raw,
+			"key":     key,
+		},
+	})
+}
+
+func ListAPIKeys(ctx *gin.Context, rdb *redis.Client) {
+	keys, err := apikey.List(ctx, rdb)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list API keys", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    keys,
+	})
+}
+
+func RotateAPIKey(ctx *gin.Context, rdb *redis.Client) {
+	raw, key, err := apikey.Rotate(ctx, rdb, ctx.Param("id"))
+	if !apiKeyFound(ctx, err) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "The previous key remains valid for 24 hours",
+		"data": gin.H{
+			"api_key": raw,
+			"key":     key,
+		},
+	})
+}
+
+func RevokeAPIKey(ctx *gin.Context, rdb *redis.Client) {
+	err := apikey.Revoke(ctx, rdb, ctx.Param("id"))
+	if !apiKeyFound(ctx, err) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "API key revoked",
+	})
+}
+
+func apiKeyFound(ctx *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, apikey.ErrKeyNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return false
+	case errors.Is(err, apikey.ErrKeyRevoked):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return false
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update API key", "details": err.Error()})
+		return false
+	}
+
+	return true
+}
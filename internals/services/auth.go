@@ -1,14 +1,23 @@
 package services
 
 import (
+	"encoding/json"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 
 	pb "github.com/AthulKrishna2501/proto-repo/auth"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/blacklist"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/cookies"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/oauth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/session"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/validator"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -26,6 +35,11 @@ func Register(ctx *gin.Context, c pb.AuthServiceClient) {
 		return
 	}
 
+	if err := validator.Passwords.Check(ctx, body.Password, body.Email, ""); err != nil {
+		respondPasswordError(ctx, err)
+		return
+	}
+
 	grpcReq := &pb.RegisterRequest{
 		Name:     body.Name,
 		Email:    body.Email,
@@ -39,6 +53,10 @@ func Register(ctx *gin.Context, c pb.AuthServiceClient) {
 		return
 	}
 
+	if err := validator.Passwords.RememberRegistration(ctx, body.Email, body.Password); err != nil {
+		log.Printf("Failed to record registration password: %v", err)
+	}
+
 	ctx.JSON(int(res.Status), &res)
 
 }
@@ -137,7 +155,7 @@ func ResendOTP(ctx *gin.Context, c pb.AuthServiceClient) {
 	ctx.JSON(int(res.Status), &res)
 }
 
-func Login(ctx *gin.Context, c pb.AuthServiceClient) {
+func Login(ctx *gin.Context, c pb.AuthServiceClient, rdb *redis.Client, cfg *config.Config) {
 	body := models.LoginRequestBody{}
 
 	if err := ctx.BindJSON(&body); err != nil {
@@ -163,11 +181,21 @@ func Login(ctx *gin.Context, c pb.AuthServiceClient) {
 		return
 	}
 
-	ctx.JSON(int(res.Status), &res)
+	if !startSession(ctx, rdb, cfg, res, "") {
+		return
+	}
+
+	respondSession(ctx, cfg, int(res.Status), res)
 
 }
 
-func GoogleLogin(ctx *gin.Context, c pb.AuthServiceClient) {
+func GoogleLogin(ctx *gin.Context, c pb.AuthServiceClient, rdb *redis.Client, cfg *config.Config) {
+	redirectURI := ctx.Query("redirect_uri")
+	if redirectURI != "" && !oauth.AllowedRedirect(redirectURI, config.GetList(cfg.OAUTH_REDIRECT_ALLOWLIST)) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "redirect_uri is not allowed"})
+		return
+	}
+
 	grpcReq := pb.GoogleLoginRequest{}
 
 	res, err := c.GoogleLogin(ctx, &grpcReq)
@@ -177,39 +205,97 @@ func GoogleLogin(ctx *gin.Context, c pb.AuthServiceClient) {
 		return
 	}
 
-	ctx.Redirect(http.StatusTemporaryRedirect, res.Url)
+	flow, err := oauth.StartFlow(ctx, rdb, "google", "", redirectURI)
+	if err != nil {
+		log.Printf("Failed to store oauth state: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start Google login"})
+		return
+	}
 
-	ctx.JSON(int(res.Status), &res)
+	authURL, err := oauth.AuthorizationURL(res.Url, flow, config.GetBool(cfg.GOOGLE_PKCE_ENABLED, true))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid Google login URL"})
+		return
+	}
+
+	cookies.SetOAuthState(ctx, cfg, flow.State, false)
+	ctx.Redirect(http.StatusTemporaryRedirect, authURL)
 }
 
-func HandleGoogleCallback(ctx *gin.Context, c pb.AuthServiceClient) {
+func HandleGoogleCallback(ctx *gin.Context, c pb.AuthServiceClient, gateway gwauth.AuthGatewayServiceClient, rdb *redis.Client, cfg *config.Config) {
+	state := ctx.Query("state")
+	if !cookies.ConsumeOAuthState(ctx, cfg, state) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Invalid OAuth state"})
+		return
+	}
 
-	code := ctx.Request.URL.Query().Get("code")
+	flow, err := oauth.ConsumeFlow(ctx, rdb, "google", state)
+	if err == oauth.ErrInvalidState {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify OAuth state"})
+		return
+	}
 
-	log.Print("Code from Request URL :", code)
+	if oauthErr := ctx.Query("error"); oauthErr != "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Google login failed", "details": oauthErr})
+		return
+	}
 
-	grpcReq := pb.GoogleCallbackRequest{
-		Code: code,
+	code := ctx.Query("code")
+	if code == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Authorization code is required"})
+		return
 	}
 
-	res, err := c.HandleGoogleCallback(ctx.Request.Context(), &grpcReq)
+	var res any
+	if config.GetBool(cfg.GOOGLE_PKCE_ENABLED, true) {
+		res, err = gateway.GoogleCallback(ctx, &gwauth.GoogleCallbackRequest{
+			Code:         code,
+			CodeVerifier: flow.Verifier,
+		})
+	} else {
+		res, err = c.HandleGoogleCallback(ctx, &pb.GoogleCallbackRequest{Code: code})
+	}
 
 	if err != nil {
 		ctx.JSON(http.StatusForbidden, err.Error())
 		return
 	}
 
-	ctx.JSON(http.StatusOK, &res)
+	completeOAuthLogin(ctx, rdb, cfg, res, flow.RedirectURI)
 }
 
-func RefreshToken(ctx *gin.Context, c pb.AuthServiceClient) {
+func RefreshToken(ctx *gin.Context, c pb.AuthServiceClient, rdb *redis.Client, cfg *config.Config) {
 	var body models.TokenRequest
 
-	if err := ctx.BindJSON(&body); err != nil {
+	if err := ctx.ShouldBindJSON(&body); err != nil && !cookies.Enabled(cfg) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Fields cannot be empty"})
 		return
 	}
 
+	if body.RefreshToken == "" && cookies.Enabled(cfg) {
+		body.RefreshToken, _ = cookies.RefreshToken(ctx)
+	}
+
+	if body.RefreshToken == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Refresh token is required"})
+		return
+	}
+
+	revoked, err := blacklist.IsRevoked(ctx, rdb, session.RevokedKey(session.ID(body.RefreshToken)))
+	if err != nil {
+		log.Printf("Failed to check refresh token: %v", err)
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": "Unable to verify session. Please try again shortly."})
+		return
+	}
+	if revoked {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Session expired. Please log in again."})
+		return
+	}
+
 	grpcReq := pb.RefreshTokenRequest{
 		RefreshToken: body.RefreshToken,
 	}
@@ -221,15 +307,23 @@ func RefreshToken(ctx *gin.Context, c pb.AuthServiceClient) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, &res)
+	if !startSession(ctx, rdb, cfg, res, body.RefreshToken) {
+		return
+	}
+
+	respondSession(ctx, cfg, http.StatusOK, res)
 }
 
-func Logout(ctx *gin.Context, c pb.AuthServiceClient) {
+func Logout(ctx *gin.Context, c pb.AuthServiceClient, rdb *redis.Client, cfg *config.Config) {
 	tokenString := ctx.GetHeader("Authorization")
 
 	if tokenString == "" {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "No token provided"})
-		return
+		cookieToken, ok := cookies.AccessToken(ctx)
+		if !ok {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "No token provided"})
+			return
+		}
+		tokenString = "Bearer " + cookieToken
 	}
 
 	tokenParts := strings.Split(tokenString, " ")
@@ -247,6 +341,127 @@ func Logout(ctx *gin.Context, c pb.AuthServiceClient) {
 		ctx.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
+
+	if err := session.Remove(ctx, rdb, token); err != nil {
+		log.Printf("Failed to remove session on logout: %v", err)
+	}
+
+	if cookies.Enabled(cfg) {
+		cookies.ClearAuthCookies(ctx, cfg)
+	}
+
 	ctx.JSON(int(res.Status), &res)
 
 }
+
+// startSession records the session for the issued tokens and sets the
+// session cookies. A refresh only returns an access token, so the
+// refresh token that was presented is passed in instead.
+func startSession(ctx *gin.Context, rdb *redis.Client, cfg *config.Config, res any, refreshToken string) bool {
+	tokens, err := tokenPair(res)
+	if err != nil {
+		log.Printf("Failed to read session tokens: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start session"})
+		return false
+	}
+
+	if refreshToken == "" {
+		refreshToken = tokens.RefreshToken
+	}
+
+	if err := session.Start(ctx, rdb, tokens.AccessToken, refreshToken, ctx.ClientIP(), ctx.Request.UserAgent()); err != nil {
+		log.Printf("Failed to bind session: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start session"})
+		return false
+	}
+
+	if !cookies.Enabled(cfg) {
+		return true
+	}
+
+	if err := cookies.SetAuthCookies(ctx, cfg, tokens.AccessToken, tokens.RefreshToken); err != nil {
+		log.Printf("Failed to set session cookies: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start session"})
+		return false
+	}
+
+	return true
+}
+
+func completeOAuthLogin(ctx *gin.Context, rdb *redis.Client, cfg *config.Config, res any, redirectURI string) {
+	if redirectURI == "" {
+		redirectURI = cfg.OAUTH_DEFAULT_REDIRECT
+	}
+
+	if !startSession(ctx, rdb, cfg, res, "") {
+		return
+	}
+
+	if redirectURI == "" {
+		respondSession(ctx, cfg, http.StatusOK, res)
+		return
+	}
+
+	if cookies.Enabled(cfg) {
+		ctx.Redirect(http.StatusFound, redirectURI)
+		return
+	}
+
+	tokens, err := tokenPair(res)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read login tokens"})
+		return
+	}
+
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid redirect URI"})
+		return
+	}
+
+	fragment := url.Values{}
+	fragment.Set("access_token", tokens.AccessToken)
+	fragment.Set("refresh_token", tokens.RefreshToken)
+	target.Fragment = fragment.Encode()
+
+	ctx.Redirect(http.StatusFound, target.String())
+}
+
+// respondSession answers a login or refresh. In cookie mode the tokens
+// only travel in the HttpOnly cookies set by startSession, so they are left
+// out of the body and the CSRF token is returned in their place.
+func respondSession(ctx *gin.Context, cfg *config.Config, code int, res any) {
+	if !cookies.Enabled(cfg) {
+		ctx.JSON(code, res)
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build login response"})
+		return
+	}
+
+	body := map[string]any{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build login response"})
+		return
+	}
+	delete(body, "access_token")
+	delete(body, "refresh_token")
+	body["csrf_token"] = ctx.Writer.Header().Get(cookies.CSRFTokenHeader)
+
+	ctx.JSON(code, body)
+}
+
+func tokenPair(res any) (models.TokenPair, error) {
+	var tokens models.TokenPair
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return tokens, err
+	}
+
+	err = json.Unmarshal(data, &tokens)
+	return tokens, err
+}
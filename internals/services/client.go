@@ -1,28 +1,24 @@
 package services
 
 import (
-	"bytes"
-	"context"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	pb "github.com/AthulKrishna2501/proto-repo/client"
-	"github.com/AthulKrishna2501/zyra-api-gateway/internals/constants"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
-	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/utils"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/validator"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/stripe/stripe-go"
-	"github.com/stripe/stripe-go/webhook"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func CreateBookingPayment(ctx *gin.Context, c pb.ClientServiceClient) {
+func CreateBookingPayment(ctx *gin.Context, c pb.ClientServiceClient, payments *payment.Registry) {
 	var body models.GenericBookingRequest
 
 	clientID, exists := ctx.Get("client_id")
@@ -43,8 +39,9 @@ func CreateBookingPayment(ctx *gin.Context, c pb.ClientServiceClient) {
 		return
 	}
 
-	if body.Method != "stripe" && body.Method != "razorpay" {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid payment method"})
+	provider, err := payments.Get(body.Method)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid payment method", "supported": payments.Names()})
 		return
 	}
 
@@ -53,11 +50,17 @@ func CreateBookingPayment(ctx *gin.Context, c pb.ClientServiceClient) {
 		return
 	}
 
+	metadata, err := provider.SessionParams(body.Metadata)
+	if err != nil {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		return
+	}
+
 	grpcReq := &pb.GenericBookingRequest{
 		UserId:      clientIDStr,
-		Method:      body.Method,
+		Method:      provider.Name(),
 		ServiceType: body.ServiceType,
-		Metadata:    body.Metadata,
+		Metadata:    metadata,
 	}
 
 	res, err := c.CreateBookingSession(ctx, grpcReq)
@@ -69,38 +72,6 @@ func CreateBookingPayment(ctx *gin.Context, c pb.ClientServiceClient) {
 	ctx.JSON(http.StatusOK, res)
 }
 
-func HandleStripeWebhook(ctx *gin.Context, c pb.ClientServiceClient, cfg *config.Config) {
-	stripe.Key = cfg.STRIPE_SECRET_KEY
-	endpointSecret := cfg.STRIPE_WEBHOOK_SECRET
-
-	body, err := io.ReadAll(ctx.Request.Body)
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
-		return
-	}
-
-	ctx.Request.Body = io.NopCloser(bytes.NewBuffer(body))
-
-	signatureHeader := ctx.GetHeader("Stripe-Signature")
-
-	event, err := webhook.ConstructEvent(body, signatureHeader, endpointSecret)
-	if err != nil {
-		fmt.Println("Webhook verification failed:", err)
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Webhook signature verification failed"})
-		return
-	}
-	_, err = c.HandleStripeEvent(context.Background(), &pb.StripeWebhookRequest{
-		EventType: event.Type,
-		Payload:   string(body),
-	})
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process event"})
-		return
-	}
-
-	ctx.Status(http.StatusOK)
-}
-
 func HostEvent(ctx *gin.Context, c pb.ClientServiceClient) {
 	var req models.CreateEventRequest
 	if err := ctx.BindJSON(&req); err != nil {
@@ -324,7 +295,7 @@ func EditClientProfile(ctx *gin.Context, c pb.ClientServiceClient) {
 	})
 }
 
-func ResetPassword(ctx *gin.Context, c pb.ClientServiceClient) {
+func ResetPassword(ctx *gin.Context, c pb.ClientServiceClient, accounts gwauth.AuthGatewayServiceClient) {
 	var req models.ResetPasswordRequest
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -332,11 +303,6 @@ func ResetPassword(ctx *gin.Context, c pb.ClientServiceClient) {
 		return
 	}
 
-	if len(req.NewPassword) < constants.PasswordMinLength {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "password must be at least 8 characters long"})
-		return
-	}
-
 	if req.NewPassword != req.ConfirmPassword {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Password and confirm password do not match"})
 		return
@@ -354,6 +320,16 @@ func ResetPassword(ctx *gin.Context, c pb.ClientServiceClient) {
 		return
 	}
 
+	email, ok := accountEmail(ctx, accounts, clientIDStr)
+	if !ok {
+		return
+	}
+
+	if err := validator.Passwords.Check(ctx, req.NewPassword, email, clientIDStr); err != nil {
+		respondPasswordError(ctx, err)
+		return
+	}
+
 	grpcReq := &pb.ResetPasswordRequest{
 		ClientId:        clientIDStr,
 		CurrentPassword: req.CurrentPassword,
@@ -367,6 +343,10 @@ func ResetPassword(ctx *gin.Context, c pb.ClientServiceClient) {
 		return
 	}
 
+	if err := validator.Passwords.Remember(ctx, clientIDStr, req.NewPassword); err != nil {
+		log.Printf("Failed to record password history for client %s: %v", clientIDStr, err)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": res.Message,
 	})
@@ -893,6 +873,19 @@ func CompleteVendorBooking(ctx *gin.Context, c pb.ClientServiceClient) {
 		return
 	}
 
+	// Anything short of a completed booking can be taken further through a
+	// dispute, so point the client at it.
+	if !strings.EqualFold(completeBookingRequest.Status, "completed") {
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": res.Message,
+			"dispute": gin.H{
+				"booking_id": completeBookingRequest.BookingID,
+				"open_url":   "/client/disputes",
+			},
+		})
+		return
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": res.Message,
 	})
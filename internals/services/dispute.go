@@ -0,0 +1,442 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func OpenDispute(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend) {
+	clientID, ok := disputeActor(ctx, dispute.RoleClient)
+	if !ok {
+		return
+	}
+
+	var req models.OpenDisputeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	booking, err := backend.Booking(ctx, clientID, req.BookingID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to fetch booking", "details": err.Error()})
+		return
+	}
+
+	if booking.Status != dispute.BookingCompleted {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": dispute.ErrNotCompleted.Error(), "booking_status": booking.Status})
+		return
+	}
+
+	var evidence []dispute.Evidence
+	if req.Description != "" || len(req.Attachments) > 0 {
+		evidence = append(evidence, dispute.NewEvidence(dispute.RoleClient, req.Description, req.Attachments))
+	}
+
+	d, err := dispute.Open(ctx, rdb, req.BookingID, clientID, booking.VendorID, req.Reason, evidence)
+	if errors.Is(err, dispute.ErrAlreadyOpen) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open dispute", "details": err.Error()})
+		return
+	}
+
+	notifyDispute(ctx, backend, d)
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Dispute opened",
+		"data":    d,
+	})
+}
+
+func ListDisputes(ctx *gin.Context, rdb *redis.Client, role string) {
+	limit, ok := listLimit(ctx)
+	if !ok {
+		return
+	}
+
+	var disputes []dispute.Dispute
+	var err error
+	if role == dispute.RoleAdmin {
+		disputes, err = dispute.List(ctx, rdb, ctx.Query("status") == "active", limit)
+	} else {
+		userID, ok := disputeActor(ctx, role)
+		if !ok {
+			return
+		}
+		disputes, err = dispute.ListFor(ctx, rdb, role, userID, limit)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch disputes", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    disputes,
+	})
+}
+
+func GetDispute(ctx *gin.Context, rdb *redis.Client, role string) {
+	userID, ok := disputeActor(ctx, role)
+	if !ok {
+		return
+	}
+
+	d, err := dispute.Get(ctx, rdb, ctx.Param("id"))
+	if err == nil && ((role == dispute.RoleClient && d.ClientID != userID) || (role == dispute.RoleVendor && d.VendorID != userID)) {
+		err = dispute.ErrNotFound
+	}
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    d,
+	})
+}
+
+func AddDisputeEvidence(ctx *gin.Context, rdb *redis.Client, role string) {
+	userID, ok := disputeActor(ctx, role)
+	if !ok {
+		return
+	}
+
+	var req models.DisputeEvidenceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	d, err := dispute.Update(ctx, rdb, ctx.Param("id"), func(d *dispute.Dispute) error {
+		if err := d.Apply(dispute.ActionEvidence, role, userID); err != nil {
+			return err
+		}
+		d.Evidence = append(d.Evidence, dispute.NewEvidence(role, req.Description, req.Attachments))
+		return nil
+	})
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Evidence added",
+		"data":    d,
+	})
+}
+
+func RespondToDispute(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend) {
+	vendorID, ok := disputeActor(ctx, dispute.RoleVendor)
+	if !ok {
+		return
+	}
+
+	var req models.DisputeResponseRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	d, err := dispute.Update(ctx, rdb, ctx.Param("id"), func(d *dispute.Dispute) error {
+		if err := d.Apply(dispute.ActionRespond, dispute.RoleVendor, vendorID); err != nil {
+			return err
+		}
+		d.VendorResponse = req.Response
+		if len(req.Attachments) > 0 {
+			d.Evidence = append(d.Evidence, dispute.NewEvidence(dispute.RoleVendor, req.Response, req.Attachments))
+		}
+		return nil
+	})
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	notifyDispute(ctx, backend, d)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Response recorded",
+		"data":    d,
+	})
+}
+
+func WithdrawDispute(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend) {
+	transitionDispute(ctx, rdb, backend, dispute.ActionWithdraw, dispute.RoleClient, "Dispute withdrawn")
+}
+
+func ReviewDispute(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend) {
+	transitionDispute(ctx, rdb, backend, dispute.ActionReview, dispute.RoleAdmin, "Dispute is under review")
+}
+
+// ResolveDispute closes the dispute first so a second admin cannot resolve
+// it again, then issues the refund when the decision calls for one. A
+// booking that already has a refund is turned away before the dispute is
+// closed; a refund that fails afterwards can be retried with
+// RetryDisputeRefund. A release decision is carried out by the admin
+// service.
+func ResolveDispute(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend, refunds refund.Backend, payments *payment.Registry) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.ResolveDisputeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	current, err := dispute.Get(ctx, rdb, ctx.Param("id"))
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	var paid *refund.Payment
+	var provider payment.Provider
+	if req.Decision == dispute.DecisionRelease {
+		req.RefundAmount = 0
+	} else {
+		paid, err = refunds.Lookup(ctx, current.ClientID, refund.KindBooking, current.BookingID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to fetch payment details", "details": err.Error()})
+			return
+		}
+
+		provider, err = payments.Get(paid.Provider)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if req.RefundAmount == 0 {
+			req.RefundAmount = paid.Amount
+		}
+		if req.RefundAmount > paid.Amount {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": refund.ErrExceedsPayment.Error(), "amount_paid": paid.Amount})
+			return
+		}
+
+		existing, err := refund.Existing(ctx, rdb, refund.KindBooking, current.BookingID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check existing refunds", "details": err.Error()})
+			return
+		}
+		if existing != "" {
+			ctx.JSON(http.StatusConflict, gin.H{"error": refund.ErrAlreadyExists.Error(), "refund_id": existing})
+			return
+		}
+	}
+
+	d, err := dispute.Update(ctx, rdb, current.ID, func(d *dispute.Dispute) error {
+		if err := d.Apply(dispute.ActionResolve, dispute.RoleAdmin, adminID); err != nil {
+			return err
+		}
+		d.Resolution = &dispute.Resolution{
+			Decision:     req.Decision,
+			RefundAmount: req.RefundAmount,
+			Note:         req.Note,
+			ResolvedBy:   adminID,
+		}
+		return nil
+	})
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	if req.Decision == dispute.DecisionRefund {
+		var refundErr error
+		d, refundErr = settleDisputeRefund(ctx, rdb, refunds, provider, d, paid)
+		if refundErr != nil {
+			ctx.JSON(http.StatusBadGateway, gin.H{"error": "Dispute resolved but the refund failed", "details": refundErr.Error(), "data": d})
+			return
+		}
+	}
+
+	notifyDispute(ctx, backend, d)
+	log.Printf("Admin %s resolved dispute %s with decision %s", adminID, d.ID, req.Decision)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Dispute resolved",
+		"data":    d,
+	})
+}
+
+// RetryDisputeRefund issues the refund of a resolved dispute again when the
+// first attempt never created one or the provider rejected it.
+func RetryDisputeRefund(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend, refunds refund.Backend, payments *payment.Registry) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	d, err := dispute.Get(ctx, rdb, ctx.Param("id"))
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	if d.Status != dispute.StatusResolved || d.Resolution == nil || d.Resolution.Decision != dispute.DecisionRefund {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Dispute was not resolved with a refund"})
+		return
+	}
+
+	if d.Resolution.RefundID != "" {
+		previous, err := refund.Get(ctx, rdb, d.Resolution.RefundID)
+		if err != nil && !errors.Is(err, refund.ErrNotFound) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch refund", "details": err.Error()})
+			return
+		}
+		if err == nil && previous.Status != refund.StatusFailed {
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Dispute refund is " + previous.Status, "refund_id": previous.ID})
+			return
+		}
+	}
+
+	paid, err := refunds.Lookup(ctx, d.ClientID, refund.KindBooking, d.BookingID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to fetch payment details", "details": err.Error()})
+		return
+	}
+
+	provider, err := payments.Get(paid.Provider)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	d, err = settleDisputeRefund(ctx, rdb, refunds, provider, d, paid)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Dispute refund failed", "details": err.Error(), "data": d})
+		return
+	}
+
+	notifyDispute(ctx, backend, d)
+	log.Printf("Admin %s retried the refund of dispute %s", adminID, d.ID)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Dispute refund issued",
+		"data":    d,
+	})
+}
+
+// settleDisputeRefund issues the refund for a resolved dispute and stores
+// the outcome on its resolution.
+func settleDisputeRefund(ctx *gin.Context, rdb *redis.Client, refunds refund.Backend, provider payment.Provider, d *dispute.Dispute, paid *refund.Payment) (*dispute.Dispute, error) {
+	r, refundErr := issueDisputeRefund(ctx, rdb, refunds, provider, d, paid)
+
+	updated, err := dispute.Update(ctx, rdb, d.ID, func(d *dispute.Dispute) error {
+		d.Resolution.RefundID = ""
+		d.Resolution.RefundError = ""
+		if r != nil {
+			d.Resolution.RefundID = r.ID
+		}
+		if refundErr != nil {
+			d.Resolution.RefundError = refundErr.Error()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Dispute %s: failed to save refund outcome: %v", d.ID, err)
+	} else {
+		d = updated
+	}
+
+	return d, refundErr
+}
+
+func issueDisputeRefund(ctx *gin.Context, rdb *redis.Client, refunds refund.Backend, provider payment.Provider, d *dispute.Dispute, paid *refund.Payment) (*refund.Refund, error) {
+	r, err := refund.CreateForAmount(ctx, rdb, d.ClientID, refund.KindBooking, d.BookingID, "dispute "+d.ID, paid, d.Resolution.RefundAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err = refund.Claim(ctx, rdb, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	r.ReviewedBy = d.Resolution.ResolvedBy
+
+	return r, refund.Process(ctx, rdb, provider, refunds, r)
+}
+
+func transitionDispute(ctx *gin.Context, rdb *redis.Client, backend dispute.Backend, action, role, message string) {
+	userID, ok := disputeActor(ctx, role)
+	if !ok {
+		return
+	}
+
+	d, err := dispute.Update(ctx, rdb, ctx.Param("id"), func(d *dispute.Dispute) error {
+		return d.Apply(action, role, userID)
+	})
+	if err != nil {
+		disputeError(ctx, err)
+		return
+	}
+
+	notifyDispute(ctx, backend, d)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": message,
+		"data":    d,
+	})
+}
+
+func notifyDispute(ctx *gin.Context, backend dispute.Backend, d *dispute.Dispute) {
+	if err := backend.Notify(ctx, d); err != nil {
+		log.Printf("Dispute %s: failed to notify services: %v", d.ID, err)
+	}
+}
+
+func disputeActor(ctx *gin.Context, role string) (string, bool) {
+	if role == dispute.RoleAdmin {
+		return getAdminID(ctx)
+	}
+
+	userID, exists := ctx.Get(role + "_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in token"})
+		return "", false
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return "", false
+	}
+
+	return userIDStr, true
+}
+
+func disputeError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, dispute.ErrNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, dispute.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, dispute.ErrInvalidTransition):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update dispute", "details": err.Error()})
+	}
+}
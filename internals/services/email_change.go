@@ -0,0 +1,129 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/constants"
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/cookies"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/emailchange"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func RequestEmailChange(ctx *gin.Context, publisher emailchange.Publisher, accounts gwauth.AuthGatewayServiceClient, rdb *redis.Client) {
+	var req models.EmailChangeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "New email is required"})
+		return
+	}
+
+	newEmail := strings.TrimSpace(req.NewEmail)
+	if !constants.EmailRegex.MatchString(newEmail) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid email format"})
+		return
+	}
+
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	if publisher == nil {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": "Email change is currently unavailable"})
+		return
+	}
+
+	if !reauthenticate(ctx, accounts, rdb, userID, req.CurrentPassword) {
+		return
+	}
+
+	code, err := emailchange.Start(ctx, rdb, userID, ctx.GetString("role"), newEmail)
+	if errors.Is(err, emailchange.ErrCooldown) {
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start email change", "details": err.Error()})
+		return
+	}
+
+	if err := publisher.PublishOTP(strings.ToLower(newEmail), code); err != nil {
+		log.Printf("Failed to publish email change OTP for user %s: %v", userID, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send verification code"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "A verification code has been sent to the new email address",
+	})
+}
+
+func ConfirmEmailChange(ctx *gin.Context, publisher emailchange.Publisher, accounts gwauth.AuthGatewayServiceClient, rdb *redis.Client, cfg *config.Config) {
+	var req models.EmailChangeConfirmRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "OTP is required"})
+		return
+	}
+
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	account, err := accounts.GetAccount(ctx, &gwauth.GetAccountRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Failed to load account %s before email change: %v", userID, err)
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to load account"})
+		return
+	}
+
+	pending, err := emailchange.Confirm(ctx, rdb, userID, req.OTP)
+	switch {
+	case errors.Is(err, emailchange.ErrNoPending):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, emailchange.ErrInvalidCode):
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, emailchange.ErrTooManyAttempts):
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify email change", "details": err.Error()})
+		return
+	}
+
+	_, err = accounts.ChangeEmail(ctx, &gwauth.ChangeEmailRequest{
+		UserId:   pending.UserID,
+		Role:     pending.Role,
+		NewEmail: pending.NewEmail,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to change email", "details": err.Error()})
+		return
+	}
+
+	if publisher != nil {
+		if err := publisher.PublishEmailChanged(account.Email, pending.NewEmail); err != nil {
+			log.Printf("Failed to notify previous email of user %s: %v", userID, err)
+		}
+	}
+
+	cookies.ClearAuthCookies(ctx, cfg)
+
+	if !revokeAfterCredentialChange(ctx, rdb, userID, "email") {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Email changed successfully. Please log in again.",
+	})
+}
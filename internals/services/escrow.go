@@ -0,0 +1,90 @@
+package services
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/escrow"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func GetEscrowRules(ctx *gin.Context, rdb *redis.Client, defaults escrow.Rules) {
+	rules, err := escrow.LoadRules(ctx, rdb, defaults)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load escrow rules", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    rules,
+	})
+}
+
+func UpdateEscrowRules(ctx *gin.Context, rdb *redis.Client) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.EscrowRulesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	rules := escrow.Rules{
+		Enabled:          req.Enabled,
+		EventDelayDays:   req.EventDelayDays,
+		BookingDelayDays: req.BookingDelayDays,
+		MaxAmount:        req.MaxAmount,
+		ExcludedVendors:  req.ExcludedVendors,
+		UpdatedBy:        adminID,
+		UpdatedAt:        time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if err := escrow.SaveRules(ctx, rdb, rules); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to save escrow rules", "details": err.Error()})
+		return
+	}
+
+	log.Printf("Admin %s updated escrow rules: enabled=%t", adminID, rules.Enabled)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Escrow rules updated",
+		"data":    rules,
+	})
+}
+
+// EscrowReport shows what the scheduler would release right now alongside
+// the outcome of its last real run.
+func EscrowReport(ctx *gin.Context, rdb *redis.Client, backend escrow.Backend, defaults escrow.Rules) {
+	rules, err := escrow.LoadRules(ctx, rdb, defaults)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load escrow rules", "details": err.Error()})
+		return
+	}
+
+	report, err := escrow.DryRun(ctx, rdb, backend, rules)
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to build escrow report", "details": err.Error()})
+		return
+	}
+
+	lastRun, err := escrow.LastRun(ctx, rdb)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load last escrow run", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"rules":    rules,
+		"data":     report,
+		"last_run": lastRun,
+	})
+}
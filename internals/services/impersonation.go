@@ -0,0 +1,113 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/impersonation"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func StartImpersonation(ctx *gin.Context, rdb *redis.Client, ttl time.Duration, accounts gwauth.AuthGatewayServiceClient) {
+	var req models.ImpersonateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "user_id, role and reason are required"})
+		return
+	}
+
+	if req.Role != "client" && req.Role != "vendor" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Only clients and vendors can be impersonated"})
+		return
+	}
+
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	session, err := impersonation.Start(ctx, rdb, adminID, req.UserID, req.Role, req.Reason, ttl)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start impersonation", "details": err.Error()})
+		return
+	}
+
+	expiresAt, _ := time.Parse(time.RFC3339, session.ExpiresAt)
+	res, err := accounts.IssueImpersonationToken(ctx, &gwauth.IssueImpersonationTokenRequest{
+		UserId:    session.UserID,
+		Role:      session.Role,
+		AdminId:   session.AdminID,
+		SessionId: session.ID,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		impersonation.End(ctx, rdb, session.ID)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue impersonation token", "details": err.Error()})
+		return
+	}
+
+	log.Printf("Impersonation: admin %s started session %s as %s %s (%s)", adminID, session.ID, req.Role, req.UserID, req.Reason)
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data": gin.H{
+			"access_token":  res.AccessToken,
+			"impersonation": session,
+		},
+	})
+}
+
+func EndImpersonation(ctx *gin.Context, rdb *redis.Client) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	sessionID := ctx.Param("id")
+	err := impersonation.End(ctx, rdb, sessionID)
+	if errors.Is(err, impersonation.ErrNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to end impersonation", "details": err.Error()})
+		return
+	}
+
+	log.Printf("Impersonation: admin %s ended session %s", adminID, sessionID)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Impersonation ended",
+	})
+}
+
+func GetImpersonationAudit(ctx *gin.Context, rdb *redis.Client) {
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "100"), 10, 64)
+	if err != nil || limit <= 0 || limit > 1000 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+		return
+	}
+
+	entries, err := impersonation.Audit(ctx, rdb, ctx.Query("before"), limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch impersonation audit", "details": err.Error()})
+		return
+	}
+
+	next := ""
+	if int64(len(entries)) == limit {
+		next = entries[len(entries)-1].ID
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    entries,
+		"next":    next,
+	})
+}
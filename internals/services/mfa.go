@@ -0,0 +1,153 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/totp"
+	"github.com/gin-gonic/gin"
+)
+
+func EnrollTOTP(ctx *gin.Context, store *totp.Store, cfg config.Config) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	enrolled, err := store.Enrolled(ctx, adminID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if enrolled {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Two-factor authentication is already enabled"})
+		return
+	}
+
+	secret, err := store.BeginEnrollment(ctx, adminID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	issuer := cfg.TOTP_ISSUER
+	if issuer == "" {
+		issuer = "Zyra Moments"
+	}
+
+	account := cfg.ADMIN_EMAIL
+	if account == "" {
+		account = adminID
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"secret":      secret,
+			"otpauth_uri": totp.URI(issuer, account, secret),
+		},
+	})
+}
+
+func ConfirmTOTPEnrollment(ctx *gin.Context, store *totp.Store) {
+	var req models.TOTPCodeRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Code is required"})
+		return
+	}
+
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := store.ConfirmEnrollment(ctx, adminID, req.Code); err != nil {
+		ctx.JSON(totpErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Two-factor authentication enabled",
+	})
+}
+
+func VerifyTOTP(ctx *gin.Context, store *totp.Store, cfg config.Config) {
+	var req models.TOTPCodeRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Code is required"})
+		return
+	}
+
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := store.Verify(ctx, adminID, req.Code); err != nil {
+		ctx.JSON(totpErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ttl := config.GetDuration(cfg.STEP_UP_TTL, 5*time.Minute)
+	if err := store.GrantStepUp(ctx, ctx.GetString("session_id"), adminID, ttl); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"message":    "Verification successful",
+		"expires_in": int(ttl.Seconds()),
+	})
+}
+
+func DisableTOTP(ctx *gin.Context, store *totp.Store) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := store.Disable(ctx, adminID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Two-factor authentication disabled",
+	})
+}
+
+func totpErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, totp.ErrInvalidCode), errors.Is(err, totp.ErrNoPending), errors.Is(err, totp.ErrNotEnrolled):
+		return http.StatusBadRequest
+	case errors.Is(err, totp.ErrTooManyAttempts):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func getAdminID(ctx *gin.Context) (string, bool) {
+	adminID, exists := ctx.Get("admin_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Admin ID not found in token"})
+		return "", false
+	}
+
+	adminIDStr, ok := adminID.(string)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid admin ID format"})
+		return "", false
+	}
+
+	return adminIDStr, true
+}
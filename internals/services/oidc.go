@@ -0,0 +1,115 @@
+package services
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/cookies"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/oauth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/oidc"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func OIDCLogin(ctx *gin.Context, registry *oidc.Registry, rdb *redis.Client, cfg *config.Config) {
+	provider, ok := registry.Get(ctx.Param("provider"))
+	if !ok {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Unknown login provider"})
+		return
+	}
+
+	role := ctx.DefaultQuery("role", "client")
+	if role != "client" && role != "vendor" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid role"})
+		return
+	}
+
+	redirectURI := ctx.Query("redirect_uri")
+	if redirectURI != "" && !oauth.AllowedRedirect(redirectURI, config.GetList(cfg.OAUTH_REDIRECT_ALLOWLIST)) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "redirect_uri is not allowed"})
+		return
+	}
+
+	flow, err := oauth.StartFlow(ctx, rdb, provider.Name(), role, redirectURI)
+	if err != nil {
+		log.Printf("Failed to store oauth state: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start login"})
+		return
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, flow.State, flow.Nonce, flow.CodeChallenge())
+	if err != nil {
+		log.Printf("Failed to build %s authorization URL: %v", provider.Name(), err)
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Login provider is unavailable"})
+		return
+	}
+
+	cookies.SetOAuthState(ctx, cfg, flow.State, provider.FormPost())
+	ctx.Redirect(http.StatusTemporaryRedirect, authURL)
+}
+
+func OIDCCallback(ctx *gin.Context, registry *oidc.Registry, exchanger oidc.IdentityExchanger, rdb *redis.Client, cfg *config.Config) {
+	provider, ok := registry.Get(ctx.Param("provider"))
+	if !ok {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Unknown login provider"})
+		return
+	}
+
+	state := callbackParam(ctx, "state")
+	if !cookies.ConsumeOAuthState(ctx, cfg, state) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Invalid OAuth state"})
+		return
+	}
+
+	flow, err := oauth.ConsumeFlow(ctx, rdb, provider.Name(), state)
+	if err == oauth.ErrInvalidState {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify OAuth state"})
+		return
+	}
+
+	if oauthErr := callbackParam(ctx, "error"); oauthErr != "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Login failed", "details": oauthErr})
+		return
+	}
+
+	code := callbackParam(ctx, "code")
+	if code == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Authorization code is required"})
+		return
+	}
+
+	identity, err := provider.Exchange(ctx, code, flow.Verifier, flow.Nonce)
+	if err != nil {
+		log.Printf("Failed to verify %s identity: %v", provider.Name(), err)
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to verify identity"})
+		return
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "A verified email address is required"})
+		return
+	}
+
+	identity.Role = flow.Role
+
+	res, err := exchanger.Exchange(ctx, identity)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	completeOAuthLogin(ctx, rdb, cfg, res, flow.RedirectURI)
+}
+
+func callbackParam(ctx *gin.Context, key string) string {
+	if value := ctx.Query(key); value != "" {
+		return value
+	}
+
+	return ctx.PostForm(key)
+}
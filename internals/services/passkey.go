@@ -0,0 +1,220 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/passkey"
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+)
+
+func BeginPasskeyRegistration(ctx *gin.Context, wa *webauthn.WebAuthn, store passkey.CredentialStore, rdb *redis.Client) {
+	if wa == nil {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": passkey.ErrNotConfigured.Error()})
+		return
+	}
+
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	role := ctx.GetString("role")
+	if role != "client" && role != "vendor" {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Passkeys are only available for clients and vendors"})
+		return
+	}
+
+	credentials, err := store.Credentials(ctx, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load passkeys", "details": err.Error()})
+		return
+	}
+
+	user := &passkey.User{ID: userID, Role: role, Name: userID, Credentials: credentials}
+
+	exclusions := make([]protocol.CredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		exclusions = append(exclusions, credential.Descriptor())
+	}
+
+	options, sessionData, err := wa.BeginRegistration(user, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start passkey registration", "details": err.Error()})
+		return
+	}
+
+	challenge := &passkey.Challenge{Session: *sessionData, UserID: userID, Role: role}
+	if err := passkey.SaveChallenge(ctx, rdb, passkey.CeremonyRegister, challenge); err != nil {
+		log.Printf("Failed to store passkey challenge: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start passkey registration"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    options,
+	})
+}
+
+func FinishPasskeyRegistration(ctx *gin.Context, wa *webauthn.WebAuthn, store passkey.CredentialStore, rdb *redis.Client) {
+	if wa == nil {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": passkey.ErrNotConfigured.Error()})
+		return
+	}
+
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid passkey registration response"})
+		return
+	}
+
+	challenge, ok := consumePasskeyChallenge(ctx, rdb, passkey.CeremonyRegister, parsed.Response.CollectedClientData.Challenge)
+	if !ok {
+		return
+	}
+
+	if challenge.UserID != userID {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": passkey.ErrInvalidChallenge.Error()})
+		return
+	}
+
+	credentials, err := store.Credentials(ctx, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load passkeys", "details": err.Error()})
+		return
+	}
+
+	user := &passkey.User{ID: userID, Role: challenge.Role, Name: userID, Credentials: credentials}
+
+	credential, err := wa.CreateCredential(user, challenge.Session, parsed)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to verify passkey", "details": err.Error()})
+		return
+	}
+
+	owner := passkey.Owner{UserID: userID, Role: challenge.Role}
+	if err := store.Save(ctx, owner, credential); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save passkey", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Passkey registered successfully",
+	})
+}
+
+func BeginPasskeyLogin(ctx *gin.Context, wa *webauthn.WebAuthn, rdb *redis.Client) {
+	if wa == nil {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": passkey.ErrNotConfigured.Error()})
+		return
+	}
+
+	options, sessionData, err := wa.BeginDiscoverableLogin()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start passkey login", "details": err.Error()})
+		return
+	}
+
+	if err := passkey.SaveChallenge(ctx, rdb, passkey.CeremonyLogin, &passkey.Challenge{Session: *sessionData}); err != nil {
+		log.Printf("Failed to store passkey challenge: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start passkey login"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    options,
+	})
+}
+
+func FinishPasskeyLogin(ctx *gin.Context, wa *webauthn.WebAuthn, store passkey.CredentialStore, issuer passkey.TokenIssuer, rdb *redis.Client, cfg *config.Config) {
+	if wa == nil {
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": passkey.ErrNotConfigured.Error()})
+		return
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid passkey login response"})
+		return
+	}
+
+	challenge, ok := consumePasskeyChallenge(ctx, rdb, passkey.CeremonyLogin, parsed.Response.CollectedClientData.Challenge)
+	if !ok {
+		return
+	}
+
+	var owner *passkey.Owner
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		found, err := store.Owner(ctx, rawID)
+		if err != nil {
+			return nil, err
+		}
+
+		if found.UserID != string(userHandle) {
+			return nil, passkey.ErrCredentialNotFound
+		}
+
+		credentials, err := store.Credentials(ctx, found.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		owner = found
+		return &passkey.User{ID: found.UserID, Role: found.Role, Name: found.UserID, Credentials: credentials}, nil
+	}
+
+	_, credential, err := wa.ValidatePasskeyLogin(handler, challenge.Session, parsed)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Passkey verification failed"})
+		return
+	}
+
+	if credential.Authenticator.CloneWarning {
+		log.Printf("Passkey sign count regressed for user %s, rejecting login", owner.UserID)
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Passkey verification failed"})
+		return
+	}
+
+	if err := store.Save(ctx, *owner, credential); err != nil {
+		log.Printf("Failed to update passkey for user %s: %v", owner.UserID, err)
+	}
+
+	res, err := issuer.Issue(ctx, owner)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !startSession(ctx, rdb, cfg, res, "") {
+		return
+	}
+
+	respondSession(ctx, cfg, http.StatusOK, res)
+}
+
+func consumePasskeyChallenge(ctx *gin.Context, rdb *redis.Client, ceremony, value string) (*passkey.Challenge, bool) {
+	challenge, err := passkey.ConsumeChallenge(ctx, rdb, ceremony, value)
+	if errors.Is(err, passkey.ErrInvalidChallenge) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify passkey challenge"})
+		return nil, false
+	}
+
+	return challenge, true
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/passwordreset"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	forgotPasswordMessage = "If an account exists for this email, a verification code has been sent"
+	// invalidResetCodeMessage is also given when no reset is pending, which
+	// is the case for every unknown email, so the answer does not reveal
+	// whether an account exists.
+	invalidResetCodeMessage = "Invalid or expired verification code"
+)
+
+func ForgotPassword(ctx *gin.Context, c gwauth.AuthGatewayServiceClient, rdb *redis.Client) {
+	body := models.OTPRequestBody{}
+	if err := ctx.BindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Fields cannot be empty"})
+		return
+	}
+
+	if err := validator.ValidateOTP(body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := c.SendPasswordResetOTP(ctx, &gwauth.PasswordResetOTPRequest{
+		Email: body.Email,
+		Role:  body.Role,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			ctx.JSON(http.StatusOK, gin.H{"message": forgotPasswordMessage})
+		case codes.ResourceExhausted:
+			ctx.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many reset attempts, please try again later"})
+		case codes.InvalidArgument:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message()})
+		default:
+			log.Printf("Failed to send password reset code: %v", err)
+			ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to send verification code"})
+		}
+		return
+	}
+
+	err = passwordreset.MarkPending(ctx, rdb, passwordreset.Request{
+		UserID: res.UserId,
+		Email:  body.Email,
+		Role:   body.Role,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start password reset"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": forgotPasswordMessage})
+}
+
+func VerifyPasswordResetOTP(ctx *gin.Context, c gwauth.AuthGatewayServiceClient, rdb *redis.Client) {
+	body := models.VerifyOTPBody{}
+	if err := ctx.BindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Fields cannot be empty"})
+		return
+	}
+
+	if err := validator.ValidateVerifyOTP(body); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := passwordreset.HasPending(ctx, rdb, body.Email); err != nil {
+		if errors.Is(err, passwordreset.ErrNoPendingReset) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": invalidResetCodeMessage})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify password reset"})
+		return
+	}
+
+	_, err := c.VerifyPasswordResetOTP(ctx, &gwauth.VerifyPasswordResetOTPRequest{
+		Email: body.Email,
+		Otp:   body.OTP,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.DeadlineExceeded:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": invalidResetCodeMessage})
+		case codes.ResourceExhausted:
+			ctx.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many attempts, please request a new code"})
+		default:
+			log.Printf("Failed to verify password reset code: %v", err)
+			ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to verify password reset"})
+		}
+		return
+	}
+
+	token, err := passwordreset.IssueToken(ctx, rdb, body.Email)
+	if errors.Is(err, passwordreset.ErrNoPendingReset) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": invalidResetCodeMessage})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue reset token"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"reset_token": token,
+			"expires_in":  int(passwordreset.TokenTTL.Seconds()),
+		},
+	})
+}
+
+func ResetForgottenPassword(ctx *gin.Context, c gwauth.AuthGatewayServiceClient, rdb *redis.Client) {
+	var req models.PasswordResetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Fields cannot be empty"})
+		return
+	}
+
+	if req.NewPassword != req.ConfirmPassword {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Password and confirm password do not match"})
+		return
+	}
+
+	pending, err := passwordreset.LookupToken(ctx, rdb, req.ResetToken)
+	if !checkResetToken(ctx, err) {
+		return
+	}
+
+	if err := validator.Passwords.Check(ctx, req.NewPassword, pending.Email, pending.UserID); err != nil {
+		respondPasswordError(ctx, err)
+		return
+	}
+
+	resetReq, err := passwordreset.ConsumeToken(ctx, rdb, req.ResetToken)
+	if !checkResetToken(ctx, err) {
+		return
+	}
+
+	_, err = c.ForceResetPassword(ctx, &gwauth.ForceResetPasswordRequest{
+		UserId:      resetReq.UserID,
+		Email:       resetReq.Email,
+		Role:        resetReq.Role,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to reset password", "details": err.Error()})
+		return
+	}
+
+	if err := validator.Passwords.Remember(ctx, resetReq.UserID, req.NewPassword); err != nil {
+		log.Printf("Failed to record password history for user %s: %v", resetReq.UserID, err)
+	}
+	if !revokeAfterCredentialChange(ctx, rdb, resetReq.UserID, "password") {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+}
+
+func checkResetToken(ctx *gin.Context, err error) bool {
+	if errors.Is(err, passwordreset.ErrInvalidToken) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify reset token"})
+		return false
+	}
+
+	return true
+}
+
+// accountEmail looks up the email of a signed-in user so password checks
+// can reject passwords built from it.
+func accountEmail(ctx *gin.Context, accounts gwauth.AuthGatewayServiceClient, userID string) (string, bool) {
+	account, err := accounts.GetAccount(ctx, &gwauth.GetAccountRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Failed to load account %s: %v", userID, err)
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to load account"})
+		return "", false
+	}
+
+	return account.Email, true
+}
+
+func respondPasswordError(ctx *gin.Context, err error) {
+	var policyErr *validator.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Password does not meet the password policy", "reasons": policyErr.Violations})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate password", "details": err.Error()})
+}
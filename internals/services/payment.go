@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/razorpay"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/webhooks"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+type PaymentEventForwarder func(ctx context.Context, event *payment.Event) error
+
+// HandlePaymentWebhook acknowledges as soon as the verified event is stored;
+// delivery to the backends happens in the webhooks worker.
+func HandlePaymentWebhook(ctx *gin.Context, payments *payment.Registry, router *webhooks.Router, name string, rdb *redis.Client) {
+	provider, err := payments.Get(name)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+		return
+	}
+
+	event, err := provider.NormalizeEvent(ctx.Request.Header, body)
+	if errors.Is(err, payment.ErrInvalidSignature) {
+		log.Printf("%s webhook verification failed", provider.Name())
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Webhook signature verification failed"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !router.Allowed(event.Provider, event.Type) {
+		log.Printf("Ignoring unrouted %s event %s", event.Provider, event.Type)
+		ctx.JSON(http.StatusOK, gin.H{"received": true, "ignored": true})
+		return
+	}
+
+	key, duplicate, err := webhooks.Enqueue(ctx, rdb, event)
+	if err != nil {
+		log.Printf("Failed to store %s event %s: %v", event.Provider, event.Type, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process event"})
+		return
+	}
+	if duplicate {
+		log.Printf("Ignoring duplicate webhook %s", key)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"received": true, "duplicate": duplicate})
+}
+
+func VerifyRazorpayPayment(ctx *gin.Context, keySecret string, forward PaymentEventForwarder) {
+	var req models.RazorpayVerifyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "razorpay_order_id, razorpay_payment_id and razorpay_signature are required"})
+		return
+	}
+
+	clientID, ok := ctx.Get("client_id")
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Client ID not found in token"})
+		return
+	}
+
+	if err := razorpay.VerifyPayment(req.OrderID, req.PaymentID, req.Signature, keySecret); err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Payment signature verification failed"})
+		return
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"event":               razorpay.EventPaymentVerified,
+		"client_id":           clientID,
+		"razorpay_order_id":   req.OrderID,
+		"razorpay_payment_id": req.PaymentID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode payment"})
+		return
+	}
+
+	event := &payment.Event{Provider: "razorpay", ID: req.PaymentID, Type: razorpay.EventPaymentVerified, Payload: payload}
+	if err := forward(ctx, event); err != nil {
+		log.Printf("Failed to forward razorpay payment %s: %v", req.PaymentID, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to confirm payment"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Payment verified",
+	})
+}
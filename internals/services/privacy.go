@@ -0,0 +1,244 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/privacy"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func RequestDataExport(ctx *gin.Context, sections func(userID, role string) []privacy.Section, rdb *redis.Client) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	role := ctx.GetString("role")
+
+	job, err := privacy.StartExport(ctx, rdb, userID, role, sections(userID, role))
+	if errors.Is(err, privacy.ErrExportLimited) {
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error(), "data": job})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start data export", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+func GetDataExport(ctx *gin.Context, rdb *redis.Client) {
+	job, ok := loadExport(ctx, rdb)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+func DownloadDataExport(ctx *gin.Context, rdb *redis.Client) {
+	job, ok := loadExport(ctx, rdb)
+	if !ok {
+		return
+	}
+
+	if job.Status != privacy.StatusReady {
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Export is not ready", "status": job.Status})
+		return
+	}
+
+	if ctx.DefaultQuery("format", "zip") == "json" {
+		data, err := privacy.ExportData(ctx, rdb, job.ID)
+		if err != nil {
+			ctx.JSON(http.StatusGone, gin.H{"error": "Export has expired"})
+			return
+		}
+
+		ctx.Header("Content-Disposition", `attachment; filename="zyra-export-`+job.ID+`.json"`)
+		ctx.Data(http.StatusOK, "application/json", data)
+		return
+	}
+
+	archive, err := privacy.ExportArchive(ctx, rdb, job.ID)
+	if err != nil {
+		ctx.JSON(http.StatusGone, gin.H{"error": "Export has expired"})
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="zyra-export-`+job.ID+`.zip"`)
+	ctx.Data(http.StatusOK, "application/zip", archive)
+}
+
+func RequestAccountDeletion(ctx *gin.Context, accounts gwauth.AuthGatewayServiceClient, rdb *redis.Client, grace time.Duration) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var body models.AccountDeletionRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+			return
+		}
+	}
+
+	if !reauthenticate(ctx, accounts, rdb, userID, body.CurrentPassword) {
+		return
+	}
+
+	req, err := privacy.ScheduleDeletion(ctx, rdb, userID, ctx.GetString("role"), grace)
+	if errors.Is(err, privacy.ErrDeletionScheduled) {
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to schedule account deletion", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"message": "Account deletion scheduled. You can cancel it before the scheduled time.",
+		"data":    req,
+	})
+}
+
+func GetAccountDeletion(ctx *gin.Context, rdb *redis.Client) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	req, err := privacy.GetDeletion(ctx, rdb, userID)
+	if errors.Is(err, privacy.ErrDeletionNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch account deletion", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    req,
+	})
+}
+
+func CancelAccountDeletion(ctx *gin.Context, rdb *redis.Client) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	err := privacy.CancelDeletion(ctx, rdb, userID)
+	if errors.Is(err, privacy.ErrDeletionNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "No cancellable account deletion was found"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel account deletion", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Account deletion cancelled",
+	})
+}
+
+func ListFailedDeletions(ctx *gin.Context, rdb *redis.Client) {
+	requests, err := privacy.FailedDeletions(ctx, rdb)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list account deletions", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    requests,
+	})
+}
+
+func RetryAccountDeletion(ctx *gin.Context, rdb *redis.Client) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	req, err := privacy.RetryDeletion(ctx, rdb, ctx.Param("user_id"))
+	if errors.Is(err, privacy.ErrDeletionNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "No failed account deletion was found"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retry account deletion", "details": err.Error()})
+		return
+	}
+
+	log.Printf("Admin %s retried account deletion for %s", adminID, req.UserID)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Account deletion queued",
+		"data":    req,
+	})
+}
+
+func AdminCancelAccountDeletion(ctx *gin.Context, rdb *redis.Client) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	userID := ctx.Param("user_id")
+	err := privacy.CancelDeletion(ctx, rdb, userID)
+	if errors.Is(err, privacy.ErrDeletionNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "No cancellable account deletion was found"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel account deletion", "details": err.Error()})
+		return
+	}
+
+	log.Printf("Admin %s cancelled account deletion for %s", adminID, userID)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Account deletion cancelled",
+	})
+}
+
+func loadExport(ctx *gin.Context, rdb *redis.Client) (*privacy.ExportJob, bool) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	job, err := privacy.GetExport(ctx, rdb, userID, ctx.Param("id"))
+	if errors.Is(err, privacy.ErrExportNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch export", "details": err.Error()})
+		return nil, false
+	}
+
+	return job, true
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// RequestRefund quotes the refund under the cancellation policy. Amounts up
+// to autoApproveLimit are paid out straight away, anything larger waits for
+// an admin.
+func RequestRefund(ctx *gin.Context, rdb *redis.Client, backend refund.Backend, policy *refund.Policy, payments *payment.Registry, autoApproveLimit int64) {
+	clientID, exists := ctx.Get("client_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Client ID not found in token"})
+		return
+	}
+
+	clientIDStr, ok := clientID.(string)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID format"})
+		return
+	}
+
+	var req models.RefundRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	paid, err := backend.Lookup(ctx, clientIDStr, req.Kind, req.ReferenceID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to fetch payment details", "details": err.Error()})
+		return
+	}
+
+	provider, err := payments.Get(paid.Provider)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := refund.Create(ctx, rdb, policy, clientIDStr, req.Kind, req.ReferenceID, req.Reason, paid)
+	switch {
+	case errors.Is(err, refund.ErrNotRefundable):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "policy": policy.Tiers()})
+		return
+	case errors.Is(err, refund.ErrAlreadyExists):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, refund.ErrInvalidKind):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create refund", "details": err.Error()})
+		return
+	}
+
+	if r.Amount > autoApproveLimit {
+		ctx.JSON(http.StatusAccepted, gin.H{
+			"success": true,
+			"message": "Refund is awaiting admin approval",
+			"data":    r,
+		})
+		return
+	}
+
+	r, err = refund.Claim(ctx, rdb, r.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process refund", "details": err.Error()})
+		return
+	}
+
+	if err := refund.Process(ctx, rdb, provider, backend, r); err != nil {
+		refundProcessError(ctx, r, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Refund issued",
+		"data":    r,
+	})
+}
+
+func ListClientRefunds(ctx *gin.Context, rdb *redis.Client) {
+	clientID, exists := ctx.Get("client_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Client ID not found in token"})
+		return
+	}
+
+	clientIDStr, ok := clientID.(string)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid client ID format"})
+		return
+	}
+
+	limit, ok := listLimit(ctx)
+	if !ok {
+		return
+	}
+
+	refunds, err := refund.ListForClient(ctx, rdb, clientIDStr, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch refunds", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    refunds,
+	})
+}
+
+func ListRefunds(ctx *gin.Context, rdb *redis.Client) {
+	limit, ok := listLimit(ctx)
+	if !ok {
+		return
+	}
+
+	pendingOnly := ctx.Query("status") == refund.StatusPendingApproval
+	refunds, err := refund.List(ctx, rdb, pendingOnly, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch refunds", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    refunds,
+	})
+}
+
+func ApproveRefund(ctx *gin.Context, rdb *redis.Client, backend refund.Backend, payments *payment.Registry) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	r, ok := claimRefund(ctx, rdb, refund.Claim)
+	if !ok {
+		return
+	}
+	r.ReviewedBy = adminID
+
+	provider, err := payments.Get(r.Provider)
+	if err != nil {
+		if failErr := refund.Fail(ctx, rdb, r, err); failErr != nil {
+			log.Printf("Refund %s: failed to save outcome: %v", r.ID, failErr)
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := refund.Process(ctx, rdb, provider, backend, r); err != nil {
+		refundProcessError(ctx, r, err)
+		return
+	}
+
+	log.Printf("Admin %s approved refund %s for %d", adminID, r.ID, r.Amount)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Refund issued",
+		"data":    r,
+	})
+}
+
+func RejectRefund(ctx *gin.Context, rdb *redis.Client, backend refund.Backend) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.RejectRefundRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request", "details": err.Error()})
+		return
+	}
+
+	r, ok := claimRefund(ctx, rdb, refund.ClaimForRejection)
+	if !ok {
+		return
+	}
+
+	if err := refund.Reject(ctx, rdb, r, adminID, req.Note); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reject refund", "details": err.Error()})
+		return
+	}
+
+	refund.RecordOutcome(ctx, rdb, backend, r)
+	if err := refund.Save(ctx, rdb, r); err != nil {
+		log.Printf("Refund %s: failed to save outcome: %v", r.ID, err)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Refund rejected",
+		"data":    r,
+	})
+}
+
+// refundProcessError reports a refund the provider rejected, or one whose
+// outcome is unknown and is back in the approval queue.
+func refundProcessError(ctx *gin.Context, r *refund.Refund, err error) {
+	if r.Status == refund.StatusUnconfirmed {
+		ctx.JSON(http.StatusAccepted, gin.H{
+			"success": true,
+			"message": "Refund outcome could not be confirmed, it is queued for admin review",
+			"data":    r,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusBadGateway, gin.H{"error": "Payment provider rejected the refund", "details": err.Error(), "data": r})
+}
+
+func claimRefund(ctx *gin.Context, rdb *redis.Client, claim func(context.Context, *redis.Client, string) (*refund.Refund, error)) (*refund.Refund, bool) {
+	r, err := claim(ctx, rdb, ctx.Param("id"))
+	switch {
+	case errors.Is(err, refund.ErrNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return nil, false
+	case errors.Is(err, refund.ErrNotPending), errors.Is(err, refund.ErrUnconfirmed):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return nil, false
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch refund", "details": err.Error()})
+		return nil, false
+	}
+
+	return r, true
+}
+
+func listLimit(ctx *gin.Context) (int64, bool) {
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "50"), 10, 64)
+	if err != nil || limit <= 0 || limit > 500 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 500"})
+		return 0, false
+	}
+
+	return limit, true
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/session"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/totp"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ListSessions(ctx *gin.Context, rdb *redis.Client) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	sessions, err := session.List(ctx, rdb, userID, ctx.GetString("session_id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch sessions", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    sessions,
+	})
+}
+
+func RevokeSession(ctx *gin.Context, rdb *redis.Client) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	sessionID := ctx.Param("id")
+	if sessionID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Session ID is required"})
+		return
+	}
+
+	err := session.Revoke(ctx, rdb, userID, sessionID)
+	if err == session.ErrSessionNotFound {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke session", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Session revoked",
+	})
+}
+
+func RevokeAllSessions(ctx *gin.Context, rdb *redis.Client) {
+	userID, ok := getUserID(ctx)
+	if !ok {
+		return
+	}
+
+	count, err := session.RevokeAll(ctx, rdb, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke sessions", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "All sessions revoked",
+		"revoked": count,
+	})
+}
+
+// revokeAfterCredentialChange signs a user out everywhere once their
+// password or email has changed. The change itself cannot be undone, so
+// revocation is retried before the request is failed with a 500.
+func revokeAfterCredentialChange(ctx *gin.Context, rdb *redis.Client, userID, changed string) bool {
+	var err error
+	for attempt := 1; attempt <= 3; attempt++ {
+		if _, err = session.RevokeAll(ctx, rdb, userID); err == nil {
+			return true
+		}
+		time.Sleep(time.Duration(attempt) * 200 * time.Millisecond)
+	}
+
+	log.Printf("Failed to revoke sessions of user %s after %s changed: %v", userID, changed, err)
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error":   "Your " + changed + " was changed, but existing sessions could not be signed out. Sign out of all sessions or contact support.",
+		"details": err.Error(),
+	})
+	return false
+}
+
+func getUserID(ctx *gin.Context) (string, bool) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "User ID not found in token"})
+		return "", false
+	}
+
+	userIDStr, ok := userID.(string)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return "", false
+	}
+
+	return userIDStr, true
+}
+
+// reauthenticate confirms a sensitive account change. A recent step-up on
+// the session is enough; otherwise the current password is checked.
+func reauthenticate(ctx *gin.Context, accounts gwauth.AuthGatewayServiceClient, rdb *redis.Client, userID, password string) bool {
+	verified, err := rdb.Exists(ctx, totp.StepUpKey(ctx.GetString("session_id"))).Result()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Server error while checking verification"})
+		return false
+	}
+	if verified > 0 {
+		return true
+	}
+
+	if password == "" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Current password is required", "reauth_required": true})
+		return false
+	}
+
+	_, err = accounts.VerifyPassword(ctx, &gwauth.VerifyPasswordRequest{UserId: userID, Password: password})
+	switch status.Code(err) {
+	case codes.OK:
+		return true
+	case codes.Unauthenticated, codes.PermissionDenied, codes.InvalidArgument:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Current password is incorrect", "reauth_required": true})
+	default:
+		log.Printf("Failed to verify password for user %s: %v", userID, err)
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to verify password"})
+	}
+
+	return false
+}
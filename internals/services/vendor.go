@@ -1,11 +1,14 @@
 package services
 
 import (
+	"log"
 	"net/http"
 
 	pb "github.com/AthulKrishna2501/proto-repo/vendor"
 	"github.com/AthulKrishna2501/zyra-api-gateway/internals/models"
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
 	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/utils"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/validator"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -191,7 +194,7 @@ func UpdateService(ctx *gin.Context, c pb.VendorSeviceClient) {
 	ctx.JSON(http.StatusOK, &res)
 }
 
-func ChangePassword(ctx *gin.Context, c pb.VendorSeviceClient) {
+func ChangePassword(ctx *gin.Context, c pb.VendorSeviceClient, accounts gwauth.AuthGatewayServiceClient) {
 	var req models.ChangePasswordRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Fields cannot be empty"})
@@ -203,13 +206,18 @@ func ChangePassword(ctx *gin.Context, c pb.VendorSeviceClient) {
 		return
 	}
 
-	if len(req.NewPassword) < 8 {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Password should be atleast 8 characters"})
+	if req.NewPassword != req.ConfirmPassword {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "New password and confirm password do not match"})
 		return
 	}
 
-	if req.NewPassword != req.ConfirmPassword {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "New password and confirm password do not match"})
+	email, ok := accountEmail(ctx, accounts, vendorID.String())
+	if !ok {
+		return
+	}
+
+	if err := validator.Passwords.Check(ctx, req.NewPassword, email, vendorID.String()); err != nil {
+		respondPasswordError(ctx, err)
 		return
 	}
 
@@ -226,6 +234,10 @@ func ChangePassword(ctx *gin.Context, c pb.VendorSeviceClient) {
 		return
 	}
 
+	if err := validator.Passwords.Remember(ctx, vendorID.String(), req.NewPassword); err != nil {
+		log.Printf("Failed to record password history for vendor %s: %v", vendorID, err)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"message": res.Message})
 }
 
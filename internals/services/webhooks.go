@@ -0,0 +1,73 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/webhooks"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func ListFailedWebhooks(ctx *gin.Context, rdb *redis.Client) {
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "50"), 10, 64)
+	if err != nil || limit <= 0 || limit > 500 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 500"})
+		return
+	}
+
+	records, err := webhooks.Failed(ctx, rdb, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch failed webhooks", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    records,
+	})
+}
+
+func GetWebhookEvent(ctx *gin.Context, rdb *redis.Client) {
+	record, err := webhooks.Get(ctx, rdb, ctx.Param("key"))
+	if errors.Is(err, webhooks.ErrNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch webhook", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    record,
+	})
+}
+
+func ReplayWebhook(ctx *gin.Context, rdb *redis.Client) {
+	adminID, ok := getAdminID(ctx)
+	if !ok {
+		return
+	}
+
+	key := ctx.Param("key")
+	record, err := webhooks.Replay(ctx, rdb, key)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to replay webhook", "details": err.Error()})
+		return
+	}
+
+	log.Printf("Webhooks: admin %s replayed %s", adminID, key)
+
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    record,
+	})
+}
@@ -0,0 +1,336 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix        = "zyra"
+	indexKey         = "apikeys"
+	DefaultRateLimit = 60
+	rotationGrace    = 24 * time.Hour
+)
+
+const (
+	ScopeEventsRead  = "events:read"
+	ScopeVendorsRead = "vendors:read"
+)
+
+var Scopes = []string{ScopeEventsRead, ScopeVendorsRead}
+
+var (
+	ErrInvalidKey   = errors.New("invalid API key")
+	ErrKeyNotFound  = errors.New("API key not found")
+	ErrKeyExpired   = errors.New("API key has expired")
+	ErrKeyRevoked   = errors.New("API key has been revoked")
+	ErrUnknownScope = errors.New("unknown API key scope")
+)
+
+type Key struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Scopes            []string `json:"scopes"`
+	RateLimit         int      `json:"rate_limit"`
+	CreatedBy         string   `json:"created_by"`
+	CreatedAt         string   `json:"created_at"`
+	ExpiresAt         string   `json:"expires_at,omitempty"`
+	RotatedAt         string   `json:"rotated_at,omitempty"`
+	RevokedAt         string   `json:"revoked_at,omitempty"`
+	Hash              string   `json:"-"`
+	PreviousHash      string   `json:"-"`
+	PreviousExpiresAt string   `json:"-"`
+}
+
+// storedKey keeps the hashes out of API responses while still persisting them.
+type storedKey struct {
+	Key
+	Hash              string `json:"hash"`
+	PreviousHash      string `json:"previous_hash,omitempty"`
+	PreviousExpiresAt string `json:"previous_expires_at,omitempty"`
+}
+
+func recordKey(id string) string {
+	return "apikey:" + id
+}
+
+func rateKey(id string, window int64) string {
+	return fmt.Sprintf("apikey:rate:%s:%d", id, window)
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int, encode func([]byte) string) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return encode(buf), nil
+}
+
+func newSecret() (string, error) {
+	return randomString(32, base64.RawURLEncoding.EncodeToString)
+}
+
+func format(id, secret string) string {
+	return keyPrefix + "_" + id + "_" + secret
+}
+
+func parse(raw string) (string, string, bool) {
+	parts := strings.SplitN(raw, "_", 3)
+	if len(parts) != 3 || parts[0] != keyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+
+	return parts[1], parts[2], true
+}
+
+func ValidScopes(scopes []string) error {
+	for _, scope := range scopes {
+		known := false
+		for _, s := range Scopes {
+			if scope == s {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%w: %s", ErrUnknownScope, scope)
+		}
+	}
+
+	return nil
+}
+
+func (k *Key) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Create(ctx context.Context, rdb *redis.Client, name string, scopes []string, rateLimit int, ttl time.Duration, createdBy string) (string, *Key, error) {
+	if err := ValidScopes(scopes); err != nil {
+		return "", nil, err
+	}
+
+	id, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return "", nil, err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if rateLimit <= 0 {
+		rateLimit = DefaultRateLimit
+	}
+
+	now := time.Now().UTC()
+	key := &Key{
+		ID:        id,
+		Name:      name,
+		Scopes:    scopes,
+		RateLimit: rateLimit,
+		CreatedBy: createdBy,
+		CreatedAt: now.Format(time.RFC3339),
+		Hash:      hashSecret(secret),
+	}
+	if ttl > 0 {
+		key.ExpiresAt = now.Add(ttl).Format(time.RFC3339)
+	}
+
+	if err := save(ctx, rdb, key); err != nil {
+		return "", nil, err
+	}
+
+	if err := rdb.SAdd(ctx, indexKey, id).Err(); err != nil {
+		return "", nil, err
+	}
+
+	return format(id, secret), key, nil
+}
+
+func Get(ctx context.Context, rdb *redis.Client, id string) (*Key, error) {
+	data, err := rdb.Get(ctx, recordKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stored storedKey
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+
+	key := stored.Key
+	key.Hash = stored.Hash
+	key.PreviousHash = stored.PreviousHash
+	key.PreviousExpiresAt = stored.PreviousExpiresAt
+	return &key, nil
+}
+
+func List(ctx context.Context, rdb *redis.Client) ([]*Key, error) {
+	ids, err := rdb.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*Key, 0, len(ids))
+	for _, id := range ids {
+		key, err := Get(ctx, rdb, id)
+		if errors.Is(err, ErrKeyNotFound) {
+			rdb.SRem(ctx, indexKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Rotate issues a new secret for the key. The previous secret keeps working
+// for a grace period so partners can roll their deployments.
+func Rotate(ctx context.Context, rdb *redis.Client, id string) (string, *Key, error) {
+	key, err := Get(ctx, rdb, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if key.RevokedAt != "" {
+		return "", nil, ErrKeyRevoked
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now().UTC()
+	key.PreviousHash = key.Hash
+	key.PreviousExpiresAt = now.Add(rotationGrace).Format(time.RFC3339)
+	key.Hash = hashSecret(secret)
+	key.RotatedAt = now.Format(time.RFC3339)
+
+	if err := save(ctx, rdb, key); err != nil {
+		return "", nil, err
+	}
+
+	return format(id, secret), key, nil
+}
+
+func Revoke(ctx context.Context, rdb *redis.Client, id string) error {
+	key, err := Get(ctx, rdb, id)
+	if err != nil {
+		return err
+	}
+
+	key.RevokedAt = time.Now().UTC().Format(time.RFC3339)
+	key.PreviousHash = ""
+	return save(ctx, rdb, key)
+}
+
+func Authenticate(ctx context.Context, rdb *redis.Client, raw string) (*Key, error) {
+	id, secret, ok := parse(raw)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+
+	key, err := Get(ctx, rdb, id)
+	if errors.Is(err, ErrKeyNotFound) {
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashSecret(secret)
+	now := time.Now()
+
+	matched := subtle.ConstantTimeCompare([]byte(hash), []byte(key.Hash)) == 1
+	if !matched && key.PreviousHash != "" && before(now, key.PreviousExpiresAt) {
+		matched = subtle.ConstantTimeCompare([]byte(hash), []byte(key.PreviousHash)) == 1
+	}
+	if !matched {
+		return nil, ErrInvalidKey
+	}
+
+	if key.RevokedAt != "" {
+		return nil, ErrKeyRevoked
+	}
+
+	if key.ExpiresAt != "" && !before(now, key.ExpiresAt) {
+		return nil, ErrKeyExpired
+	}
+
+	return key, nil
+}
+
+// Allow applies the key's per-minute request limit using a fixed window.
+func Allow(ctx context.Context, rdb *redis.Client, key *Key) (bool, int, error) {
+	window := time.Now().Unix() / 60
+	counter := rateKey(key.ID, window)
+
+	count, err := rdb.Incr(ctx, counter).Result()
+	if err != nil {
+		return false, 0, err
+	}
+	if count == 1 {
+		rdb.Expire(ctx, counter, 2*time.Minute)
+	}
+
+	remaining := key.RateLimit - int(count)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return int(count) <= key.RateLimit, remaining, nil
+}
+
+func before(now time.Time, value string) bool {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+
+	return now.Before(t)
+}
+
+func save(ctx context.Context, rdb *redis.Client, key *Key) error {
+	data, err := json.Marshal(storedKey{
+		Key:               *key,
+		Hash:              key.Hash,
+		PreviousHash:      key.PreviousHash,
+		PreviousExpiresAt: key.PreviousExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, recordKey(key.ID), data, 0).Err()
+}
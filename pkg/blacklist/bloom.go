@@ -0,0 +1,67 @@
+package blacklist
+
+import (
+	"hash/fnv"
+	"math"
+	"sync"
+)
+
+type bloomFilter struct {
+	mu     sync.RWMutex
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+func newBloomFilter(expectedItems int, falsePositiveRate float64) *bloomFilter {
+	if expectedItems <= 0 {
+		expectedItems = 1
+	}
+
+	size := uint64(math.Ceil(-float64(expectedItems) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/float64(expectedItems)*math.Ln2)))
+
+	return &bloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+func (b *bloomFilter) Add(key string) {
+	h1, h2 := bloomHashes(key)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i := uint64(0); i < b.hashes; i++ {
+		pos := (h1 + i*h2) % b.size
+		b.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (b *bloomFilter) MayContain(key string) bool {
+	h1, h2 := bloomHashes(key)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for i := uint64(0); i < b.hashes; i++ {
+		pos := (h1 + i*h2) % b.size
+		if b.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func bloomHashes(key string) (uint64, uint64) {
+	first := fnv.New64a()
+	first.Write([]byte(key))
+
+	second := fnv.New64()
+	second.Write([]byte(key))
+
+	return first.Sum64(), second.Sum64() | 1
+}
@@ -0,0 +1,337 @@
+package blacklist
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/session"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrUnavailable = errors.New("token blacklist unavailable")
+
+// fallbackTTL bounds how long a cached "not revoked" answer can hide a
+// revocation when keyspace notifications are off.
+const fallbackTTL = 5 * time.Second
+
+var LocalCache *Cache
+
+var (
+	metrics        = expvar.NewMap("blacklist_cache")
+	bloomNegatives = new(expvar.Int)
+	lruHits        = new(expvar.Int)
+	lruMisses      = new(expvar.Int)
+	redisLookups   = new(expvar.Int)
+	redisErrors    = new(expvar.Int)
+	failOpens      = new(expvar.Int)
+	failCloses     = new(expvar.Int)
+)
+
+func init() {
+	metrics.Set("bloom_negatives", bloomNegatives)
+	metrics.Set("lru_hits", lruHits)
+	metrics.Set("lru_misses", lruMisses)
+	metrics.Set("redis_lookups", redisLookups)
+	metrics.Set("redis_errors", redisErrors)
+	metrics.Set("fail_open", failOpens)
+	metrics.Set("fail_closed", failCloses)
+	metrics.Set("hit_rate", expvar.Func(func() any {
+		hits := bloomNegatives.Value() + lruHits.Value()
+		total := hits + lruMisses.Value()
+		if total == 0 {
+			return 0.0
+		}
+		return float64(hits) / float64(total)
+	}))
+}
+
+type Options struct {
+	Prefixes      []string
+	CacheSize     int
+	CacheTTL      time.Duration
+	BloomItems    int
+	RebuildEvery  time.Duration
+	LookupTimeout time.Duration
+	FailOpen      bool
+}
+
+type Cache struct {
+	rdb  *redis.Client
+	opts Options
+	lru  *lruCache
+
+	mu        sync.RWMutex
+	rebuildMu sync.Mutex
+	bloom     *bloomFilter
+	next      *bloomFilter
+
+	notifications bool
+	ready         atomic.Bool
+	generation    atomic.Uint64
+}
+
+func InitCache(ctx context.Context, rdb *redis.Client, cfg *config.Config) {
+	LocalCache = NewCache(rdb, Options{
+		Prefixes:      []string{"blacklist:", session.RevokedPrefix},
+		CacheSize:     config.GetInt(cfg.BLACKLIST_CACHE_SIZE, 10000),
+		CacheTTL:      config.GetDuration(cfg.BLACKLIST_CACHE_TTL, 5*time.Second),
+		BloomItems:    config.GetInt(cfg.BLACKLIST_BLOOM_ITEMS, 100000),
+		RebuildEvery:  config.GetDuration(cfg.BLACKLIST_BLOOM_REBUILD, 10*time.Minute),
+		LookupTimeout: config.GetDuration(cfg.REDIS_TIMEOUT, 200*time.Millisecond),
+		FailOpen:      config.GetBool(cfg.BLACKLIST_FAIL_OPEN, false),
+	})
+
+	LocalCache.Start(ctx)
+}
+
+func NewCache(rdb *redis.Client, opts Options) *Cache {
+	return &Cache{
+		rdb:   rdb,
+		opts:  opts,
+		lru:   newLRUCache(opts.CacheSize, opts.CacheTTL),
+		bloom: newBloomFilter(opts.BloomItems, 0.01),
+	}
+}
+
+func IsRevoked(ctx context.Context, rdb *redis.Client, keys ...string) (bool, error) {
+	if LocalCache != nil {
+		return LocalCache.IsRevoked(ctx, keys...)
+	}
+
+	count, err := rdb.Exists(ctx, keys...).Result()
+	return count > 0, err
+}
+
+// Start enables the bloom filter when Redis publishes keyspace events. The
+// gateway never changes Redis config itself: operators must set
+// notify-keyspace-events to include K$gx (or KA) for the filter to be used.
+// Without it every lookup goes through the LRU, capped at fallbackTTL.
+func (c *Cache) Start(ctx context.Context) {
+	c.notifications = c.notificationsEnabled(ctx)
+	if !c.notifications {
+		log.Println("Blacklist cache: notify-keyspace-events does not include K$gx, running without bloom filter")
+		if c.opts.CacheTTL > fallbackTTL {
+			c.lru = newLRUCache(c.opts.CacheSize, fallbackTTL)
+		}
+		return
+	}
+
+	go c.watch(ctx)
+	go c.rebuildPeriodically(ctx)
+}
+
+func (c *Cache) IsRevoked(ctx context.Context, keys ...string) (bool, error) {
+	trusted := c.ready.Load()
+	pending := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		if trusted && !c.mayContain(key) {
+			bloomNegatives.Add(1)
+			continue
+		}
+
+		if revoked, found := c.lru.Get(key); found {
+			lruHits.Add(1)
+			if revoked {
+				return true, nil
+			}
+			continue
+		}
+
+		lruMisses.Add(1)
+		pending = append(pending, key)
+	}
+
+	if len(pending) == 0 {
+		return false, nil
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, c.opts.LookupTimeout)
+	defer cancel()
+
+	redisLookups.Add(1)
+	cmds := make([]*redis.IntCmd, len(pending))
+	_, err := c.rdb.Pipelined(lookupCtx, func(pipe redis.Pipeliner) error {
+		for i, key := range pending {
+			cmds[i] = pipe.Exists(lookupCtx, key)
+		}
+		return nil
+	})
+	if err != nil {
+		redisErrors.Add(1)
+		if c.opts.FailOpen {
+			failOpens.Add(1)
+			log.Println("Blacklist cache: Redis lookup failed, failing open:", err)
+			return false, nil
+		}
+
+		failCloses.Add(1)
+		return false, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+
+	revoked := false
+	for i, cmd := range cmds {
+		isRevoked := cmd.Val() > 0
+		c.lru.Set(pending[i], isRevoked)
+		if isRevoked {
+			revoked = true
+		}
+	}
+
+	return revoked, nil
+}
+
+func (c *Cache) mayContain(key string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.bloom.MayContain(key)
+}
+
+func (c *Cache) add(key string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	c.bloom.Add(key)
+	if c.next != nil {
+		c.next.Add(key)
+	}
+}
+
+func (c *Cache) notificationsEnabled(ctx context.Context) bool {
+	current, err := c.rdb.ConfigGet(ctx, "notify-keyspace-events").Result()
+	if err != nil {
+		log.Println("Blacklist cache: failed to read notify-keyspace-events:", err)
+		return false
+	}
+
+	flags := current["notify-keyspace-events"]
+	required := "K$gx"
+	if strings.Contains(flags, "A") {
+		required = "K"
+	}
+
+	for _, flag := range required {
+		if !strings.ContainsRune(flags, flag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Cache) watch(ctx context.Context) {
+	patterns := make([]string, 0, len(c.opts.Prefixes))
+	for _, prefix := range c.opts.Prefixes {
+		patterns = append(patterns, "__keyspace@*__:"+prefix+"*")
+	}
+
+	pubsub := c.rdb.PSubscribe(ctx, patterns...)
+	defer pubsub.Close()
+
+	for {
+		msg, err := pubsub.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			c.generation.Add(1)
+			if c.ready.Swap(false) {
+				log.Println("Blacklist cache: lost keyspace subscription, bypassing bloom filter:", err)
+			}
+			time.Sleep(time.Second)
+			continue
+		}
+
+		switch m := msg.(type) {
+		case *redis.Subscription:
+			if m.Kind == "psubscribe" && m.Count == len(patterns) {
+				generation := c.generation.Load()
+				go func() {
+					if err := c.rebuild(ctx); err != nil {
+						log.Println("Blacklist cache: failed to build bloom filter:", err)
+						return
+					}
+					if c.generation.Load() == generation {
+						c.ready.Store(true)
+					}
+				}()
+			}
+		case *redis.Message:
+			c.handleEvent(m.Channel, m.Payload)
+		}
+	}
+}
+
+func (c *Cache) handleEvent(channel, event string) {
+	idx := strings.Index(channel, "__:")
+	if idx < 0 {
+		return
+	}
+	key := channel[idx+3:]
+
+	switch event {
+	case "set":
+		c.add(key)
+		c.lru.Set(key, true)
+	case "del", "expired", "evicted":
+		c.lru.Remove(key)
+	}
+}
+
+func (c *Cache) rebuildPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(c.opts.RebuildEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.rebuild(ctx); err != nil {
+				log.Println("Blacklist cache: failed to rebuild bloom filter:", err)
+			}
+		}
+	}
+}
+
+func (c *Cache) rebuild(ctx context.Context) error {
+	c.rebuildMu.Lock()
+	defer c.rebuildMu.Unlock()
+
+	next := newBloomFilter(c.opts.BloomItems, 0.01)
+
+	c.mu.Lock()
+	c.next = next
+	c.mu.Unlock()
+
+	for _, prefix := range c.opts.Prefixes {
+		iter := c.rdb.Scan(ctx, 0, prefix+"*", 1000).Iterator()
+		for iter.Next(ctx) {
+			next.Add(iter.Val())
+		}
+
+		if err := iter.Err(); err != nil {
+			c.mu.Lock()
+			c.next = nil
+			c.mu.Unlock()
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	c.bloom = next
+	c.next = nil
+	c.mu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+)
+
+const (
+	HCaptchaURL  = "https://api.hcaptcha.com/siteverify"
+	TurnstileURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+	ReCAPTCHAURL = "https://www.google.com/recaptcha/api/siteverify"
+
+	DefaultFakeToken = "captcha-pass"
+)
+
+var (
+	ErrMissingToken = errors.New("captcha token is required")
+	ErrFailed       = errors.New("captcha verification failed")
+)
+
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// New returns nil when CAPTCHA_PROVIDER is empty, which disables the check.
+func New(cfg *config.Config) (Verifier, error) {
+	provider := strings.ToLower(strings.TrimSpace(cfg.CAPTCHA_PROVIDER))
+	if provider == "" {
+		return nil, nil
+	}
+
+	if provider == "fake" {
+		if cfg.APP_ENV != "development" {
+			return nil, errors.New("the fake captcha provider is only allowed when APP_ENV=development")
+		}
+
+		token := cfg.CAPTCHA_FAKE_TOKEN
+		if token == "" {
+			token = DefaultFakeToken
+		}
+		return &Fake{Token: token}, nil
+	}
+
+	if cfg.CAPTCHA_SECRET == "" {
+		return nil, fmt.Errorf("CAPTCHA_SECRET is required for provider %q", provider)
+	}
+
+	verifier := &SiteVerifier{
+		Secret: cfg.CAPTCHA_SECRET,
+		Client: &http.Client{Timeout: 5 * time.Second},
+	}
+
+	switch provider {
+	case "hcaptcha":
+		verifier.URL = HCaptchaURL
+	case "turnstile":
+		verifier.URL = TurnstileURL
+	case "recaptcha":
+		verifier.URL = ReCAPTCHAURL
+		verifier.MinScore = config.GetFloat(cfg.CAPTCHA_MIN_SCORE, 0.5)
+	default:
+		return nil, fmt.Errorf("unknown captcha provider %q", provider)
+	}
+
+	return verifier, nil
+}
+
+// SiteVerifier talks to the siteverify endpoint shared by hCaptcha,
+// Turnstile and reCAPTCHA. MinScore only applies to reCAPTCHA v3 responses.
+type SiteVerifier struct {
+	URL      string
+	Secret   string
+	MinScore float64
+	Client   *http.Client
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (v *SiteVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return ErrMissingToken
+	}
+
+	form := url.Values{"secret": {v.Secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("captcha provider unavailable: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha provider returned status %d", resp.StatusCode)
+	}
+
+	var result siteVerifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("invalid captcha provider response: %w", err)
+	}
+
+	if !result.Success {
+		return fmt.Errorf("%w: %s", ErrFailed, strings.Join(result.ErrorCodes, ","))
+	}
+	if result.Score != nil && *result.Score < v.MinScore {
+		return fmt.Errorf("%w: score %.2f below %.2f", ErrFailed, *result.Score, v.MinScore)
+	}
+
+	return nil
+}
+
+// Fake accepts a single fixed token and is meant for local development and
+// automated testing of the signup flow.
+type Fake struct {
+	Token string
+}
+
+func (f *Fake) Verify(_ context.Context, token, _ string) error {
+	if token == "" {
+		return ErrMissingToken
+	}
+	if token != f.Token {
+		return ErrFailed
+	}
+
+	return nil
+}
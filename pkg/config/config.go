@@ -23,6 +23,118 @@ type Config struct {
 	STRIPE_WEBHOOK_SECRET string `mapstructure:"STRIPE_WEBHOOK_SECRET"`
 	ADMIN_EMAIL           string `mapstructure:"ADMIN_EMAIL"`
 	SECRET_NAME           string `mapstructure:"SECRET_NAME"`
+
+	RAZORPAY_KEY_ID         string `mapstructure:"RAZORPAY_KEY_ID"`
+	RAZORPAY_KEY_SECRET     string `mapstructure:"RAZORPAY_KEY_SECRET"`
+	RAZORPAY_WEBHOOK_SECRET string `mapstructure:"RAZORPAY_WEBHOOK_SECRET"`
+	WEBHOOK_ROUTES          string `mapstructure:"WEBHOOK_ROUTES"`
+
+	REFUND_POLICY             string `mapstructure:"REFUND_POLICY"`
+	REFUND_AUTO_APPROVE_LIMIT string `mapstructure:"REFUND_AUTO_APPROVE_LIMIT"`
+
+	ESCROW_RULES              string `mapstructure:"ESCROW_RULES"`
+	ESCROW_SCHEDULER_INTERVAL string `mapstructure:"ESCROW_SCHEDULER_INTERVAL"`
+
+	REDIS_TIMEOUT           string `mapstructure:"REDIS_TIMEOUT"`
+	BLACKLIST_CACHE_SIZE    string `mapstructure:"BLACKLIST_CACHE_SIZE"`
+	BLACKLIST_CACHE_TTL     string `mapstructure:"BLACKLIST_CACHE_TTL"`
+	BLACKLIST_BLOOM_ITEMS   string `mapstructure:"BLACKLIST_BLOOM_ITEMS"`
+	BLACKLIST_BLOOM_REBUILD string `mapstructure:"BLACKLIST_BLOOM_REBUILD"`
+	BLACKLIST_FAIL_OPEN     string `mapstructure:"BLACKLIST_FAIL_OPEN"`
+
+	COOKIE_SESSIONS        string `mapstructure:"COOKIE_SESSIONS"`
+	COOKIE_DOMAIN          string `mapstructure:"COOKIE_DOMAIN"`
+	COOKIE_SECURE          string `mapstructure:"COOKIE_SECURE"`
+	COOKIE_SAMESITE        string `mapstructure:"COOKIE_SAMESITE"`
+	REFRESH_COOKIE_MAX_AGE string `mapstructure:"REFRESH_COOKIE_MAX_AGE"`
+
+	OAUTH_REDIRECT_ALLOWLIST string `mapstructure:"OAUTH_REDIRECT_ALLOWLIST"`
+	OAUTH_DEFAULT_REDIRECT   string `mapstructure:"OAUTH_DEFAULT_REDIRECT"`
+	GOOGLE_PKCE_ENABLED      string `mapstructure:"GOOGLE_PKCE_ENABLED"`
+	OIDC_PROVIDERS           string `mapstructure:"OIDC_PROVIDERS"`
+
+	TOTP_ISSUER         string `mapstructure:"TOTP_ISSUER"`
+	TOTP_ENCRYPTION_KEY string `mapstructure:"TOTP_ENCRYPTION_KEY"`
+	STEP_UP_TTL         string `mapstructure:"STEP_UP_TTL"`
+
+	PASSKEY_RP_ID   string `mapstructure:"PASSKEY_RP_ID"`
+	PASSKEY_RP_NAME string `mapstructure:"PASSKEY_RP_NAME"`
+	PASSKEY_ORIGINS string `mapstructure:"PASSKEY_ORIGINS"`
+
+	PASSWORD_MIN_LENGTH     string `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PASSWORD_REQUIRE_UPPER  string `mapstructure:"PASSWORD_REQUIRE_UPPER"`
+	PASSWORD_REQUIRE_LOWER  string `mapstructure:"PASSWORD_REQUIRE_LOWER"`
+	PASSWORD_REQUIRE_DIGIT  string `mapstructure:"PASSWORD_REQUIRE_DIGIT"`
+	PASSWORD_REQUIRE_SYMBOL string `mapstructure:"PASSWORD_REQUIRE_SYMBOL"`
+	PASSWORD_BREACHED_LIST  string `mapstructure:"PASSWORD_BREACHED_LIST"`
+	PASSWORD_HISTORY_SIZE   string `mapstructure:"PASSWORD_HISTORY_SIZE"`
+
+	RABBITMQ_URL string `mapstructure:"RABBITMQ_URL"`
+
+	ACCOUNT_DELETION_GRACE string `mapstructure:"ACCOUNT_DELETION_GRACE"`
+
+	IMPERSONATION_TTL string `mapstructure:"IMPERSONATION_TTL"`
+
+	APP_ENV                    string `mapstructure:"APP_ENV"`
+	GRPC_TLS_MODE              string `mapstructure:"GRPC_TLS_MODE"`
+	GRPC_TLS_RELOAD_INTERVAL   string `mapstructure:"GRPC_TLS_RELOAD_INTERVAL"`
+	AUTH_SVC_TLS_MODE          string `mapstructure:"AUTH_SVC_TLS_MODE"`
+	AUTH_SVC_TLS_CA            string `mapstructure:"AUTH_SVC_TLS_CA"`
+	AUTH_SVC_TLS_CERT          string `mapstructure:"AUTH_SVC_TLS_CERT"`
+	AUTH_SVC_TLS_KEY           string `mapstructure:"AUTH_SVC_TLS_KEY"`
+	AUTH_SVC_TLS_SERVER_NAME   string `mapstructure:"AUTH_SVC_TLS_SERVER_NAME"`
+	CLIENT_SVC_TLS_MODE        string `mapstructure:"CLIENT_SVC_TLS_MODE"`
+	CLIENT_SVC_TLS_CA          string `mapstructure:"CLIENT_SVC_TLS_CA"`
+	CLIENT_SVC_TLS_CERT        string `mapstructure:"CLIENT_SVC_TLS_CERT"`
+	CLIENT_SVC_TLS_KEY         string `mapstructure:"CLIENT_SVC_TLS_KEY"`
+	CLIENT_SVC_TLS_SERVER_NAME string `mapstructure:"CLIENT_SVC_TLS_SERVER_NAME"`
+	ADMIN_SVC_TLS_MODE         string `mapstructure:"ADMIN_SVC_TLS_MODE"`
+	ADMIN_SVC_TLS_CA           string `mapstructure:"ADMIN_SVC_TLS_CA"`
+	ADMIN_SVC_TLS_CERT         string `mapstructure:"ADMIN_SVC_TLS_CERT"`
+	ADMIN_SVC_TLS_KEY          string `mapstructure:"ADMIN_SVC_TLS_KEY"`
+	ADMIN_SVC_TLS_SERVER_NAME  string `mapstructure:"ADMIN_SVC_TLS_SERVER_NAME"`
+	VENDOR_SVC_TLS_MODE        string `mapstructure:"VENDOR_SVC_TLS_MODE"`
+	VENDOR_SVC_TLS_CA          string `mapstructure:"VENDOR_SVC_TLS_CA"`
+	VENDOR_SVC_TLS_CERT        string `mapstructure:"VENDOR_SVC_TLS_CERT"`
+	VENDOR_SVC_TLS_KEY         string `mapstructure:"VENDOR_SVC_TLS_KEY"`
+	VENDOR_SVC_TLS_SERVER_NAME string `mapstructure:"VENDOR_SVC_TLS_SERVER_NAME"`
+
+	CORS_ALLOW_ORIGINS     string `mapstructure:"CORS_ALLOW_ORIGINS"`
+	CORS_ALLOW_METHODS     string `mapstructure:"CORS_ALLOW_METHODS"`
+	CORS_ALLOW_HEADERS     string `mapstructure:"CORS_ALLOW_HEADERS"`
+	CORS_EXPOSE_HEADERS    string `mapstructure:"CORS_EXPOSE_HEADERS"`
+	CORS_ALLOW_CREDENTIALS string `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORS_MAX_AGE           string `mapstructure:"CORS_MAX_AGE"`
+	CORS_ROUTE_POLICIES    string `mapstructure:"CORS_ROUTE_POLICIES"`
+
+	HSTS_MAX_AGE               string `mapstructure:"HSTS_MAX_AGE"`
+	HSTS_INCLUDE_SUBDOMAINS    string `mapstructure:"HSTS_INCLUDE_SUBDOMAINS"`
+	HSTS_PRELOAD               string `mapstructure:"HSTS_PRELOAD"`
+	CONTENT_SECURITY_POLICY    string `mapstructure:"CONTENT_SECURITY_POLICY"`
+	REFERRER_POLICY            string `mapstructure:"REFERRER_POLICY"`
+	FRAME_OPTIONS              string `mapstructure:"FRAME_OPTIONS"`
+	MAX_BODY_SIZE              string `mapstructure:"MAX_BODY_SIZE"`
+	MAX_BODY_SIZE_ROUTES       string `mapstructure:"MAX_BODY_SIZE_ROUTES"`
+	JSON_MAX_DEPTH             string `mapstructure:"JSON_MAX_DEPTH"`
+	JSON_MAX_FIELDS            string `mapstructure:"JSON_MAX_FIELDS"`
+	ALLOWED_CONTENT_TYPES      string `mapstructure:"ALLOWED_CONTENT_TYPES"`
+	SERVER_READ_HEADER_TIMEOUT string `mapstructure:"SERVER_READ_HEADER_TIMEOUT"`
+	SERVER_READ_TIMEOUT        string `mapstructure:"SERVER_READ_TIMEOUT"`
+	SERVER_WRITE_TIMEOUT       string `mapstructure:"SERVER_WRITE_TIMEOUT"`
+	SERVER_IDLE_TIMEOUT        string `mapstructure:"SERVER_IDLE_TIMEOUT"`
+	SERVER_SHUTDOWN_TIMEOUT    string `mapstructure:"SERVER_SHUTDOWN_TIMEOUT"`
+
+	TRUSTED_PROXIES  string `mapstructure:"TRUSTED_PROXIES"`
+	TRUSTED_PLATFORM string `mapstructure:"TRUSTED_PLATFORM"`
+	NETWORK_POLICIES string `mapstructure:"NETWORK_POLICIES"`
+	GEOIP_DB_PATH    string `mapstructure:"GEOIP_DB_PATH"`
+
+	CAPTCHA_PROVIDER     string `mapstructure:"CAPTCHA_PROVIDER"`
+	CAPTCHA_SECRET       string `mapstructure:"CAPTCHA_SECRET"`
+	CAPTCHA_MIN_SCORE    string `mapstructure:"CAPTCHA_MIN_SCORE"`
+	CAPTCHA_FAKE_TOKEN   string `mapstructure:"CAPTCHA_FAKE_TOKEN"`
+	CAPTCHA_ROUTES       string `mapstructure:"CAPTCHA_ROUTES"`
+	CAPTCHA_BYPASS_CIDRS string `mapstructure:"CAPTCHA_BYPASS_CIDRS"`
 }
 
 func LoadConfig() (cfg Config, err error) {
@@ -0,0 +1,179 @@
+package cookies
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AccessTokenCookie  = "zyra_access_token"
+	RefreshTokenCookie = "zyra_refresh_token"
+	CSRFTokenCookie    = "zyra_csrf_token"
+	CSRFTokenHeader    = "X-CSRF-Token"
+	OAuthStateCookie   = "zyra_oauth_state"
+
+	refreshCookiePath = "/auth"
+	oauthStateMaxAge  = 10 * time.Minute
+)
+
+func Enabled(cfg *config.Config) bool {
+	return config.GetBool(cfg.COOKIE_SESSIONS, false)
+}
+
+func SetAuthCookies(ctx *gin.Context, cfg *config.Config, accessToken, refreshToken string) error {
+	if accessToken != "" {
+		setCookie(ctx, cfg, AccessTokenCookie, accessToken, "/", tokenMaxAge(accessToken, 15*time.Minute), true)
+	}
+
+	if refreshToken != "" {
+		maxAge := config.GetDuration(cfg.REFRESH_COOKIE_MAX_AGE, 7*24*time.Hour)
+		setCookie(ctx, cfg, RefreshTokenCookie, refreshToken, refreshCookiePath, tokenMaxAge(refreshToken, maxAge), true)
+	}
+
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return err
+	}
+
+	setCookie(ctx, cfg, CSRFTokenCookie, csrfToken, "/", config.GetDuration(cfg.REFRESH_COOKIE_MAX_AGE, 7*24*time.Hour), false)
+	ctx.Header(CSRFTokenHeader, csrfToken)
+
+	return nil
+}
+
+func ClearAuthCookies(ctx *gin.Context, cfg *config.Config) {
+	setCookie(ctx, cfg, AccessTokenCookie, "", "/", -time.Second, true)
+	setCookie(ctx, cfg, RefreshTokenCookie, "", refreshCookiePath, -time.Second, true)
+	setCookie(ctx, cfg, CSRFTokenCookie, "", "/", -time.Second, false)
+}
+
+func AccessToken(ctx *gin.Context) (string, bool) {
+	token, err := ctx.Cookie(AccessTokenCookie)
+	if err != nil || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+func RefreshToken(ctx *gin.Context) (string, bool) {
+	token, err := ctx.Cookie(RefreshTokenCookie)
+	if err != nil || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+// SetOAuthState stores the login state for the callback to check. A Lax
+// cookie is not sent on a cross-site POST, so providers that answer with
+// form_post get a SameSite=None cookie, which browsers only accept as Secure.
+func SetOAuthState(ctx *gin.Context, cfg *config.Config, state string, formPost bool) {
+	cookie := &http.Cookie{
+		Name:     OAuthStateCookie,
+		Value:    state,
+		Path:     refreshCookiePath,
+		Domain:   cfg.COOKIE_DOMAIN,
+		MaxAge:   int(oauthStateMaxAge.Seconds()),
+		Secure:   config.GetBool(cfg.COOKIE_SECURE, true),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+
+	if formPost {
+		cookie.SameSite = http.SameSiteNoneMode
+		cookie.Secure = true
+	}
+
+	http.SetCookie(ctx.Writer, cookie)
+}
+
+func ConsumeOAuthState(ctx *gin.Context, cfg *config.Config, state string) bool {
+	cookieState, err := ctx.Cookie(OAuthStateCookie)
+	setCookie(ctx, cfg, OAuthStateCookie, "", refreshCookiePath, -time.Second, true)
+
+	if err != nil || cookieState == "" || state == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(cookieState), []byte(state)) == 1
+}
+
+func ValidCSRF(ctx *gin.Context) bool {
+	cookieToken, err := ctx.Cookie(CSRFTokenCookie)
+	if err != nil || cookieToken == "" {
+		return false
+	}
+
+	headerToken := ctx.GetHeader(CSRFTokenHeader)
+	if headerToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(cookieToken), []byte(headerToken)) == 1
+}
+
+func setCookie(ctx *gin.Context, cfg *config.Config, name, value, path string, maxAge time.Duration, httpOnly bool) {
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   cfg.COOKIE_DOMAIN,
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   config.GetBool(cfg.COOKIE_SECURE, true),
+		HttpOnly: httpOnly,
+		SameSite: sameSite(cfg.COOKIE_SAMESITE),
+	}
+
+	if maxAge < 0 {
+		cookie.MaxAge = -1
+	}
+
+	http.SetCookie(ctx.Writer, cookie)
+}
+
+func sameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
+
+func tokenMaxAge(token string, fallback time.Duration) time.Duration {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return fallback
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return fallback
+	}
+
+	if ttl := time.Until(exp.Time); ttl > 0 {
+		return ttl
+	}
+
+	return fallback
+}
+
+func newCSRFToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,100 @@
+package dispute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+	gwvendor "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/vendor"
+)
+
+// BookingCompleted is the only booking status a dispute can be opened for.
+const BookingCompleted = "completed"
+
+// Booking is what the client service returns for a booking the client
+// wants to dispute.
+type Booking struct {
+	VendorID string
+	Status   string
+}
+
+type Backend interface {
+	Booking(ctx context.Context, clientID, bookingID string) (*Booking, error)
+	Notify(ctx context.Context, d *Dispute) error
+}
+
+type GRPCBackend struct {
+	Client gwclient.ClientGatewayServiceClient
+	Vendor gwvendor.VendorGatewayServiceClient
+	Admin  gwadmin.AdminGatewayServiceClient
+}
+
+func (b *GRPCBackend) Booking(ctx context.Context, clientID, bookingID string) (*Booking, error) {
+	res, err := b.Client.GetDisputableBooking(ctx, &gwclient.GetDisputableBookingRequest{
+		ClientId:  clientID,
+		BookingId: bookingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.VendorId == "" {
+		return nil, fmt.Errorf("booking %s has no vendor", bookingID)
+	}
+
+	return &Booking{VendorID: res.VendorId, Status: res.Status}, nil
+}
+
+// Notify keeps every service's copy of the dispute current. The admin
+// service acts on release decisions; refunds are issued by the gateway.
+func (b *GRPCBackend) Notify(ctx context.Context, d *Dispute) error {
+	var decision, refundID string
+	var refundAmount int64
+	if d.Resolution != nil {
+		decision = d.Resolution.Decision
+		refundAmount = d.Resolution.RefundAmount
+		refundID = d.Resolution.RefundID
+	}
+
+	var errs []error
+	if _, err := b.Client.RecordDispute(ctx, &gwclient.DisputeRecord{
+		DisputeId:    d.ID,
+		BookingId:    d.BookingID,
+		ClientId:     d.ClientID,
+		VendorId:     d.VendorID,
+		Status:       d.Status,
+		Decision:     decision,
+		RefundAmount: refundAmount,
+		RefundId:     refundID,
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("client: %w", err))
+	}
+	if _, err := b.Vendor.RecordDispute(ctx, &gwvendor.DisputeRecord{
+		DisputeId:    d.ID,
+		BookingId:    d.BookingID,
+		ClientId:     d.ClientID,
+		VendorId:     d.VendorID,
+		Status:       d.Status,
+		Decision:     decision,
+		RefundAmount: refundAmount,
+		RefundId:     refundID,
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("vendor: %w", err))
+	}
+	if _, err := b.Admin.RecordDispute(ctx, &gwadmin.DisputeRecord{
+		DisputeId:    d.ID,
+		BookingId:    d.BookingID,
+		ClientId:     d.ClientID,
+		VendorId:     d.VendorID,
+		Status:       d.Status,
+		Decision:     decision,
+		RefundAmount: refundAmount,
+		RefundId:     refundID,
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("admin: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,142 @@
+package dispute
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	StatusOpen        = "open"
+	StatusResponded   = "vendor_responded"
+	StatusUnderReview = "under_review"
+	StatusResolved    = "resolved"
+	StatusWithdrawn   = "withdrawn"
+
+	ActionRespond  = "respond"
+	ActionEvidence = "evidence"
+	ActionReview   = "review"
+	ActionResolve  = "resolve"
+	ActionWithdraw = "withdraw"
+
+	RoleClient = "client"
+	RoleVendor = "vendor"
+	RoleAdmin  = "admin"
+
+	DecisionRefund  = "refund"
+	DecisionRelease = "release"
+)
+
+var (
+	ErrNotFound          = errors.New("dispute not found")
+	ErrAlreadyOpen       = errors.New("this booking already has an active dispute")
+	ErrForbidden         = errors.New("dispute belongs to another account")
+	ErrInvalidTransition = errors.New("action is not allowed in the dispute's current state")
+	ErrNotCompleted      = errors.New("only completed bookings can be disputed")
+)
+
+type Evidence struct {
+	Role        string   `json:"role"`
+	Description string   `json:"description"`
+	Attachments []string `json:"attachments,omitempty"`
+	SubmittedAt string   `json:"submitted_at"`
+}
+
+type Transition struct {
+	Action string `json:"action"`
+	Role   string `json:"role"`
+	UserID string `json:"user_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	At     string `json:"at"`
+}
+
+type Resolution struct {
+	Decision     string `json:"decision"`
+	RefundAmount int64  `json:"refund_amount,omitempty"`
+	RefundID     string `json:"refund_id,omitempty"`
+	RefundError  string `json:"refund_error,omitempty"`
+	Note         string `json:"note"`
+	ResolvedBy   string `json:"resolved_by"`
+}
+
+type Dispute struct {
+	ID             string       `json:"id"`
+	BookingID      string       `json:"booking_id"`
+	ClientID       string       `json:"client_id"`
+	VendorID       string       `json:"vendor_id"`
+	Reason         string       `json:"reason"`
+	Status         string       `json:"status"`
+	Evidence       []Evidence   `json:"evidence"`
+	VendorResponse string       `json:"vendor_response,omitempty"`
+	Resolution     *Resolution  `json:"resolution,omitempty"`
+	History        []Transition `json:"history"`
+	CreatedAt      string       `json:"created_at"`
+	UpdatedAt      string       `json:"updated_at"`
+}
+
+type rule struct {
+	roles []string
+	from  []string
+	to    string
+}
+
+// rules is the whole state machine. An empty to keeps the current status,
+// which is how evidence can be added without moving the dispute along.
+var rules = map[string]rule{
+	ActionRespond:  {roles: []string{RoleVendor}, from: []string{StatusOpen}, to: StatusResponded},
+	ActionEvidence: {roles: []string{RoleClient, RoleVendor}, from: []string{StatusOpen, StatusResponded, StatusUnderReview}},
+	ActionReview:   {roles: []string{RoleAdmin}, from: []string{StatusOpen, StatusResponded}, to: StatusUnderReview},
+	ActionResolve:  {roles: []string{RoleAdmin}, from: []string{StatusOpen, StatusResponded, StatusUnderReview}, to: StatusResolved},
+	ActionWithdraw: {roles: []string{RoleClient}, from: []string{StatusOpen, StatusResponded}, to: StatusWithdrawn},
+}
+
+// Apply checks that role may perform action on d and records the
+// transition. Clients and vendors can only act on their own disputes.
+func (d *Dispute) Apply(action, role, userID string) error {
+	r, ok := rules[action]
+	if !ok {
+		return fmt.Errorf("unknown dispute action %q", action)
+	}
+
+	if !slices.Contains(r.roles, role) {
+		return fmt.Errorf("%w: %s cannot %s", ErrInvalidTransition, role, action)
+	}
+	if (role == RoleClient && d.ClientID != userID) || (role == RoleVendor && d.VendorID != userID) {
+		return ErrForbidden
+	}
+	if !slices.Contains(r.from, d.Status) {
+		return fmt.Errorf("%w: cannot %s while the dispute is %s", ErrInvalidTransition, action, d.Status)
+	}
+
+	to := r.to
+	if to == "" {
+		to = d.Status
+	}
+
+	d.History = append(d.History, Transition{
+		Action: action,
+		Role:   role,
+		UserID: userID,
+		From:   d.Status,
+		To:     to,
+		At:     time.Now().UTC().Format(time.RFC3339),
+	})
+	d.Status = to
+
+	return nil
+}
+
+func (d *Dispute) Active() bool {
+	return d.Status != StatusResolved && d.Status != StatusWithdrawn
+}
+
+func NewEvidence(role, description string, attachments []string) Evidence {
+	return Evidence{
+		Role:        role,
+		Description: description,
+		Attachments: attachments,
+		SubmittedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,82 @@
+package dispute
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		action  string
+		role    string
+		userID  string
+		wantErr error
+		wantTo  string
+	}{
+		{name: "vendor responds to open dispute", status: StatusOpen, action: ActionRespond, role: RoleVendor, userID: "vendor-1", wantTo: StatusResponded},
+		{name: "vendor cannot respond twice", status: StatusResponded, action: ActionRespond, role: RoleVendor, userID: "vendor-1", wantErr: ErrInvalidTransition},
+		{name: "client cannot respond", status: StatusOpen, action: ActionRespond, role: RoleClient, userID: "client-1", wantErr: ErrInvalidTransition},
+		{name: "other vendor cannot respond", status: StatusOpen, action: ActionRespond, role: RoleVendor, userID: "vendor-2", wantErr: ErrForbidden},
+		{name: "client adds evidence", status: StatusOpen, action: ActionEvidence, role: RoleClient, userID: "client-1", wantTo: StatusOpen},
+		{name: "vendor adds evidence under review", status: StatusUnderReview, action: ActionEvidence, role: RoleVendor, userID: "vendor-1", wantTo: StatusUnderReview},
+		{name: "other client cannot add evidence", status: StatusOpen, action: ActionEvidence, role: RoleClient, userID: "client-2", wantErr: ErrForbidden},
+		{name: "no evidence after resolution", status: StatusResolved, action: ActionEvidence, role: RoleClient, userID: "client-1", wantErr: ErrInvalidTransition},
+		{name: "admin cannot add evidence", status: StatusOpen, action: ActionEvidence, role: RoleAdmin, userID: "admin-1", wantErr: ErrInvalidTransition},
+		{name: "admin reviews responded dispute", status: StatusResponded, action: ActionReview, role: RoleAdmin, userID: "admin-1", wantTo: StatusUnderReview},
+		{name: "admin cannot review twice", status: StatusUnderReview, action: ActionReview, role: RoleAdmin, userID: "admin-1", wantErr: ErrInvalidTransition},
+		{name: "vendor cannot review", status: StatusOpen, action: ActionReview, role: RoleVendor, userID: "vendor-1", wantErr: ErrInvalidTransition},
+		{name: "admin resolves open dispute", status: StatusOpen, action: ActionResolve, role: RoleAdmin, userID: "admin-1", wantTo: StatusResolved},
+		{name: "admin resolves under review", status: StatusUnderReview, action: ActionResolve, role: RoleAdmin, userID: "admin-1", wantTo: StatusResolved},
+		{name: "admin cannot resolve twice", status: StatusResolved, action: ActionResolve, role: RoleAdmin, userID: "admin-1", wantErr: ErrInvalidTransition},
+		{name: "admin cannot resolve withdrawn", status: StatusWithdrawn, action: ActionResolve, role: RoleAdmin, userID: "admin-1", wantErr: ErrInvalidTransition},
+		{name: "client withdraws responded dispute", status: StatusResponded, action: ActionWithdraw, role: RoleClient, userID: "client-1", wantTo: StatusWithdrawn},
+		{name: "client cannot withdraw under review", status: StatusUnderReview, action: ActionWithdraw, role: RoleClient, userID: "client-1", wantErr: ErrInvalidTransition},
+		{name: "other client cannot withdraw", status: StatusOpen, action: ActionWithdraw, role: RoleClient, userID: "client-2", wantErr: ErrForbidden},
+		{name: "vendor cannot withdraw", status: StatusOpen, action: ActionWithdraw, role: RoleVendor, userID: "vendor-1", wantErr: ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Dispute{ClientID: "client-1", VendorID: "vendor-1", Status: tt.status}
+
+			err := d.Apply(tt.action, tt.role, tt.userID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Apply() error = %v, want %v", err, tt.wantErr)
+				}
+				if d.Status != tt.status || len(d.History) != 0 {
+					t.Fatalf("rejected action changed the dispute: status %q, %d transitions", d.Status, len(d.History))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+
+			if d.Status != tt.wantTo {
+				t.Errorf("status = %q, want %q", d.Status, tt.wantTo)
+			}
+			if len(d.History) != 1 {
+				t.Fatalf("got %d transitions, want 1", len(d.History))
+			}
+			got := d.History[0]
+			if got.Action != tt.action || got.Role != tt.role || got.UserID != tt.userID || got.From != tt.status || got.To != tt.wantTo || got.At == "" {
+				t.Errorf("transition = %+v", got)
+			}
+		})
+	}
+}
+
+func TestApplyUnknownAction(t *testing.T) {
+	d := &Dispute{ClientID: "client-1", VendorID: "vendor-1", Status: StatusOpen}
+
+	err := d.Apply("escalate", RoleAdmin, "admin-1")
+	if err == nil || errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("Apply() error = %v, want an unknown action error", err)
+	}
+	if d.Status != StatusOpen || len(d.History) != 0 {
+		t.Fatalf("unknown action changed the dispute")
+	}
+}
@@ -0,0 +1,203 @@
+package dispute
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	allKey    = "disputes:all"
+	activeKey = "disputes:active"
+)
+
+func disputeKey(id string) string {
+	return "dispute:" + id
+}
+
+func bookingKey(bookingID string) string {
+	return "dispute:booking:" + bookingID
+}
+
+func decisionKey(bookingID string) string {
+	return "dispute:decision:" + bookingID
+}
+
+func ownerKey(role, userID string) string {
+	return "disputes:" + role + ":" + userID
+}
+
+// Open starts a dispute on a booking. A booking can only have one active
+// dispute at a time.
+func Open(ctx context.Context, rdb *redis.Client, bookingID, clientID, vendorID, reason string, evidence []Evidence) (*Dispute, error) {
+	now := time.Now().UTC()
+	d := &Dispute{
+		ID:        uuid.NewString(),
+		BookingID: bookingID,
+		ClientID:  clientID,
+		VendorID:  vendorID,
+		Reason:    reason,
+		Status:    StatusOpen,
+		Evidence:  evidence,
+		History: []Transition{{
+			Action: "open",
+			Role:   RoleClient,
+			UserID: clientID,
+			To:     StatusOpen,
+			At:     now.Format(time.RFC3339),
+		}},
+		CreatedAt: now.Format(time.RFC3339),
+		UpdatedAt: now.Format(time.RFC3339),
+	}
+
+	claimed, err := rdb.SetNX(ctx, bookingKey(bookingID), d.ID, 0).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, ErrAlreadyOpen
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	score := float64(now.Unix())
+	pipe := rdb.TxPipeline()
+	pipe.Set(ctx, disputeKey(d.ID), data, 0)
+	pipe.ZAdd(ctx, ownerKey(RoleClient, clientID), redis.Z{Score: score, Member: d.ID})
+	pipe.ZAdd(ctx, ownerKey(RoleVendor, vendorID), redis.Z{Score: score, Member: d.ID})
+	pipe.ZAdd(ctx, allKey, redis.Z{Score: score, Member: d.ID})
+	pipe.ZAdd(ctx, activeKey, redis.Z{Score: score, Member: d.ID})
+	if _, err := pipe.Exec(ctx); err != nil {
+		rdb.Del(ctx, bookingKey(bookingID))
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func Get(ctx context.Context, rdb *redis.Client, id string) (*Dispute, error) {
+	return get(ctx, rdb, id)
+}
+
+func get(ctx context.Context, cmd redis.Cmdable, id string) (*Dispute, error) {
+	data, err := cmd.Get(ctx, disputeKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var d Dispute
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// Update loads the dispute, lets fn change it and writes it back, retrying
+// when another request modified the dispute in between.
+func Update(ctx context.Context, rdb *redis.Client, id string, fn func(d *Dispute) error) (*Dispute, error) {
+	var updated *Dispute
+
+	txf := func(tx *redis.Tx) error {
+		d, err := get(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := fn(d); err != nil {
+			return err
+		}
+		d.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+		data, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, disputeKey(id), data, 0)
+			if !d.Active() {
+				pipe.ZRem(ctx, activeKey, id)
+				pipe.Del(ctx, bookingKey(d.BookingID))
+			}
+			if d.Status == StatusResolved && d.Resolution != nil {
+				pipe.Set(ctx, decisionKey(d.BookingID), d.Resolution.Decision, 0)
+			}
+			return nil
+		})
+		if err == nil {
+			updated = d
+		}
+		return err
+	}
+
+	for range 3 {
+		err := rdb.Watch(ctx, txf, disputeKey(id))
+		if !errors.Is(err, redis.TxFailedErr) {
+			return updated, err
+		}
+	}
+
+	return nil, redis.TxFailedErr
+}
+
+// ActiveForBooking reports whether a booking has an unresolved dispute.
+func ActiveForBooking(ctx context.Context, rdb *redis.Client, bookingID string) (bool, error) {
+	n, err := rdb.Exists(ctx, bookingKey(bookingID)).Result()
+	return n > 0, err
+}
+
+// DecisionForBooking returns how the last resolved dispute on a booking
+// was decided, or an empty string when none was resolved.
+func DecisionForBooking(ctx context.Context, rdb *redis.Client, bookingID string) (string, error) {
+	decision, err := rdb.Get(ctx, decisionKey(bookingID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+
+	return decision, err
+}
+
+func ListFor(ctx context.Context, rdb *redis.Client, role, userID string, limit int64) ([]Dispute, error) {
+	return list(ctx, rdb, ownerKey(role, userID), limit)
+}
+
+// List returns every dispute, or only unresolved ones when activeOnly is
+// set, newest first.
+func List(ctx context.Context, rdb *redis.Client, activeOnly bool, limit int64) ([]Dispute, error) {
+	if activeOnly {
+		return list(ctx, rdb, activeKey, limit)
+	}
+
+	return list(ctx, rdb, allKey, limit)
+}
+
+func list(ctx context.Context, rdb *redis.Client, key string, limit int64) ([]Dispute, error) {
+	ids, err := rdb.ZRevRange(ctx, key, 0, limit-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	disputes := make([]Dispute, 0, len(ids))
+	for _, id := range ids {
+		d, err := Get(ctx, rdb, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		disputes = append(disputes, *d)
+	}
+
+	return disputes, nil
+}
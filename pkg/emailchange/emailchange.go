@@ -0,0 +1,147 @@
+package emailchange
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	pendingTTL  = 10 * time.Minute
+	cooldown    = time.Minute
+	maxAttempts = 5
+)
+
+var (
+	ErrNoPending       = errors.New("no pending email change")
+	ErrInvalidCode     = errors.New("invalid verification code")
+	ErrTooManyAttempts = errors.New("too many verification attempts, request a new code")
+	ErrCooldown        = errors.New("a verification code was sent recently, try again shortly")
+)
+
+// Publisher sends the code to the new address and tells the old address
+// once the change has gone through.
+type Publisher interface {
+	PublishOTP(email, otp string) error
+	PublishEmailChanged(oldEmail, newEmail string) error
+}
+
+type Pending struct {
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	NewEmail string `json:"new_email"`
+	CodeHash string `json:"code_hash"`
+}
+
+func pendingKey(userID string) string {
+	return "emailchange:" + userID
+}
+
+func attemptsKey(userID string) string {
+	return "emailchange:attempts:" + userID
+}
+
+func cooldownKey(userID string) string {
+	return "emailchange:cooldown:" + userID
+}
+
+func hashCode(userID, code string) string {
+	sum := sha256.Sum256([]byte(userID + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func Start(ctx context.Context, rdb *redis.Client, userID, role, newEmail string) (string, error) {
+	ok, err := rdb.SetNX(ctx, cooldownKey(userID), 1, cooldown).Result()
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrCooldown
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(Pending{
+		UserID:   userID,
+		Role:     role,
+		NewEmail: strings.ToLower(newEmail),
+		CodeHash: hashCode(userID, code),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	_, err = rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, pendingKey(userID), data, pendingTTL)
+		pipe.Del(ctx, attemptsKey(userID))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+func Confirm(ctx context.Context, rdb *redis.Client, userID, code string) (*Pending, error) {
+	data, err := rdb.Get(ctx, pendingKey(userID)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrNoPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var pending Pending
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return nil, err
+	}
+
+	attempts, err := rdb.Incr(ctx, attemptsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if attempts == 1 {
+		rdb.Expire(ctx, attemptsKey(userID), pendingTTL)
+	}
+	if attempts > maxAttempts {
+		rdb.Del(ctx, pendingKey(userID))
+		return nil, ErrTooManyAttempts
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashCode(userID, code)), []byte(pending.CodeHash)) != 1 {
+		return nil, ErrInvalidCode
+	}
+
+	deleted, err := rdb.Del(ctx, pendingKey(userID), attemptsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, ErrNoPending
+	}
+
+	return &pending, nil
+}
@@ -0,0 +1,82 @@
+package escrow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+)
+
+const (
+	KindEvent   = "event"
+	KindBooking = "booking"
+)
+
+// Candidate is money held in escrow for a finished event or completed
+// booking. BookingIDs and TicketIDs list what was sold for an event so a
+// dispute or refund on any of them holds the whole release.
+type Candidate struct {
+	Kind        string    `json:"kind"`
+	ReferenceID string    `json:"reference_id"`
+	ClientID    string    `json:"client_id"`
+	VendorID    string    `json:"vendor_id,omitempty"`
+	Amount      int64     `json:"amount"`
+	CompletedAt time.Time `json:"completed_at"`
+	BookingIDs  []string  `json:"booking_ids,omitempty"`
+	TicketIDs   []string  `json:"ticket_ids,omitempty"`
+}
+
+type Backend interface {
+	Candidates(ctx context.Context) ([]Candidate, error)
+	RequestRelease(ctx context.Context, c Candidate) error
+}
+
+// GRPCBackend lists held funds from the admin service. Event releases go
+// through the typed RequestFundRelease call the client endpoint already
+// uses, supplied as ReleaseEvent.
+type GRPCBackend struct {
+	Admin        gwadmin.AdminGatewayServiceClient
+	Client       gwclient.ClientGatewayServiceClient
+	ReleaseEvent func(ctx context.Context, clientID, eventID string) error
+}
+
+func (b *GRPCBackend) Candidates(ctx context.Context) ([]Candidate, error) {
+	res, err := b.Admin.ListHeldFunds(ctx, &gwadmin.ListHeldFundsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]Candidate, 0, len(res.Items))
+	for _, item := range res.Items {
+		if item.ReferenceId == "" || item.CompletedAt == nil || (item.Kind != KindEvent && item.Kind != KindBooking) {
+			return nil, fmt.Errorf("malformed held fund entry %v", item)
+		}
+
+		candidates = append(candidates, Candidate{
+			Kind:        item.Kind,
+			ReferenceID: item.ReferenceId,
+			ClientID:    item.ClientId,
+			VendorID:    item.VendorId,
+			Amount:      item.Amount,
+			CompletedAt: item.CompletedAt.AsTime(),
+			BookingIDs:  item.BookingIds,
+			TicketIDs:   item.TicketIds,
+		})
+	}
+
+	return candidates, nil
+}
+
+func (b *GRPCBackend) RequestRelease(ctx context.Context, c Candidate) error {
+	if c.Kind == KindEvent {
+		return b.ReleaseEvent(ctx, c.ClientID, c.ReferenceID)
+	}
+
+	_, err := b.Client.RequestBookingFundRelease(ctx, &gwclient.BookingFundReleaseRequest{
+		ClientId:  c.ClientID,
+		BookingId: c.ReferenceID,
+	})
+	return err
+}
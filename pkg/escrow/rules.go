@@ -0,0 +1,87 @@
+package escrow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const rulesKey = "escrow:rules"
+
+// Rules decide when the scheduler asks for funds to be released. Amounts
+// above MaxAmount and vendors in ExcludedVendors are left for an admin;
+// MaxAmount 0 means no cap.
+type Rules struct {
+	Enabled          bool     `json:"enabled"`
+	EventDelayDays   int      `json:"event_delay_days"`
+	BookingDelayDays int      `json:"booking_delay_days"`
+	MaxAmount        int64    `json:"max_amount"`
+	ExcludedVendors  []string `json:"excluded_vendors"`
+	UpdatedBy        string   `json:"updated_by,omitempty"`
+	UpdatedAt        string   `json:"updated_at,omitempty"`
+}
+
+var defaultRules = Rules{
+	EventDelayDays:   3,
+	BookingDelayDays: 3,
+}
+
+// ParseRules reads ESCROW_RULES. Auto release stays off unless the rules
+// enable it, either here or through the admin endpoint.
+func ParseRules(rulesJSON string) (Rules, error) {
+	rules := defaultRules
+	if strings.TrimSpace(rulesJSON) != "" {
+		if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+			return Rules{}, fmt.Errorf("invalid ESCROW_RULES: %w", err)
+		}
+	}
+
+	return rules, rules.Validate()
+}
+
+func (r Rules) Validate() error {
+	if r.EventDelayDays < 0 || r.EventDelayDays > 365 || r.BookingDelayDays < 0 || r.BookingDelayDays > 365 {
+		return errors.New("release delays must be between 0 and 365 days")
+	}
+	if r.MaxAmount < 0 {
+		return errors.New("max_amount cannot be negative")
+	}
+
+	return nil
+}
+
+// LoadRules returns the rules saved by an admin, or defaults when none
+// have been saved yet.
+func LoadRules(ctx context.Context, rdb *redis.Client, defaults Rules) (Rules, error) {
+	data, err := rdb.Get(ctx, rulesKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return defaults, nil
+	}
+	if err != nil {
+		return Rules{}, err
+	}
+
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return Rules{}, err
+	}
+
+	return rules, nil
+}
+
+func SaveRules(ctx context.Context, rdb *redis.Client, rules Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, rulesKey, data, 0).Err()
+}
@@ -0,0 +1,329 @@
+package escrow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	leaderKey  = "escrow:scheduler:leader"
+	lastRunKey = "escrow:last_run"
+
+	ActionRelease = "release"
+	ActionSkip    = "skip"
+	ActionFailed  = "failed"
+)
+
+type Decision struct {
+	Candidate
+	Action string `json:"action"`
+	Reason string `json:"reason,omitempty"`
+	DueAt  string `json:"due_at"`
+}
+
+type Report struct {
+	RanAt     string     `json:"ran_at"`
+	DryRun    bool       `json:"dry_run"`
+	Enabled   bool       `json:"enabled"`
+	Released  int        `json:"released"`
+	Skipped   int        `json:"skipped"`
+	Failed    int        `json:"failed"`
+	Decisions []Decision `json:"decisions"`
+	Errors    []string   `json:"errors,omitempty"`
+}
+
+// Plan decides what a run would do right now without changing anything.
+func Plan(ctx context.Context, store Store, backend Backend, rules Rules, now time.Time) ([]Decision, error) {
+	candidates, err := backend.Candidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]Decision, 0, len(candidates))
+	for _, c := range candidates {
+		delay := rules.BookingDelayDays
+		if c.Kind == KindEvent {
+			delay = rules.EventDelayDays
+		}
+		due := c.CompletedAt.Add(time.Duration(delay) * 24 * time.Hour)
+
+		d := Decision{Candidate: c, Action: ActionSkip, DueAt: due.UTC().Format(time.RFC3339)}
+		d.Reason, err = skipReason(ctx, store, rules, c, due, now)
+		if err != nil {
+			return nil, err
+		}
+		if d.Reason == "" {
+			d.Action = ActionRelease
+		}
+
+		decisions = append(decisions, d)
+	}
+
+	return decisions, nil
+}
+
+func skipReason(ctx context.Context, store Store, rules Rules, c Candidate, due, now time.Time) (string, error) {
+	requested, err := store.Requested(ctx, c.Kind, c.ReferenceID)
+	if err != nil {
+		return "", err
+	}
+	if requested {
+		return "release already requested", nil
+	}
+
+	if c.VendorID != "" && slices.Contains(rules.ExcludedVendors, c.VendorID) {
+		return "vendor is excluded from auto release", nil
+	}
+	if rules.MaxAmount > 0 && c.Amount > rules.MaxAmount {
+		return "amount is above the auto release limit", nil
+	}
+	if now.Before(due) {
+		return "not due yet", nil
+	}
+
+	bookings := slices.Clone(c.BookingIDs)
+	if c.Kind == KindBooking {
+		bookings = append(bookings, c.ReferenceID)
+	}
+	for _, bookingID := range bookings {
+		disputed, err := store.Disputed(ctx, bookingID)
+		if err != nil {
+			return "", err
+		}
+		if disputed {
+			return "booking " + bookingID + " is disputed", nil
+		}
+
+		decision, err := store.DisputeDecision(ctx, bookingID)
+		if err != nil {
+			return "", err
+		}
+		if decision == dispute.DecisionRefund {
+			return "booking " + bookingID + " was refunded in a dispute", nil
+		}
+
+		refunded, err := store.Refunded(ctx, refund.KindBooking, bookingID)
+		if err != nil {
+			return "", err
+		}
+		if refunded {
+			return "booking " + bookingID + " has a refund", nil
+		}
+	}
+
+	for _, ticketID := range c.TicketIDs {
+		refunded, err := store.Refunded(ctx, refund.KindTicket, ticketID)
+		if err != nil {
+			return "", err
+		}
+		if refunded {
+			return "ticket " + ticketID + " has a refund", nil
+		}
+	}
+
+	return "", nil
+}
+
+// DryRun reports what a run would do now. Released counts the releases it
+// would request.
+func DryRun(ctx context.Context, rdb *redis.Client, backend Backend, rules Rules) (*Report, error) {
+	decisions, err := Plan(ctx, redisStore{rdb}, backend, rules, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{RanAt: time.Now().UTC().Format(time.RFC3339), DryRun: true, Enabled: rules.Enabled, Decisions: decisions}
+	for _, d := range decisions {
+		if d.Action == ActionRelease {
+			report.Released++
+		} else {
+			report.Skipped++
+		}
+	}
+
+	return report, nil
+}
+
+// Run requests release for everything Plan marks as due. The requested
+// marker keeps a release from being asked for twice, even across a leader
+// change mid-run, until the funds leave the held list.
+func Run(ctx context.Context, rdb *redis.Client, backend Backend, rules Rules) (*Report, error) {
+	decisions, err := Plan(ctx, redisStore{rdb}, backend, rules, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{RanAt: time.Now().UTC().Format(time.RFC3339), Enabled: rules.Enabled, Decisions: decisions}
+
+	candidates := make([]Candidate, len(decisions))
+	for i, d := range decisions {
+		candidates[i] = d.Candidate
+	}
+	if err := forgetReleased(ctx, rdb, candidates); err != nil {
+		report.Errors = append(report.Errors, "failed to clear old release requests: "+err.Error())
+	}
+
+	for i, d := range decisions {
+		if d.Action != ActionRelease {
+			report.Skipped++
+			continue
+		}
+
+		field := requestedField(d.Kind, d.ReferenceID)
+		claimed, err := rdb.HSetNX(ctx, requestedKey, field, report.RanAt).Result()
+		if err != nil {
+			decisions[i].Action = ActionFailed
+			decisions[i].Reason = err.Error()
+			report.Failed++
+			report.Errors = append(report.Errors, d.Kind+" "+d.ReferenceID+": "+err.Error())
+			continue
+		}
+		if !claimed {
+			decisions[i].Action = ActionSkip
+			decisions[i].Reason = "release already requested"
+			report.Skipped++
+			continue
+		}
+
+		releaseCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		err = backend.RequestRelease(releaseCtx, d.Candidate)
+		cancel()
+
+		if err != nil {
+			rdb.HDel(ctx, requestedKey, field)
+			decisions[i].Action = ActionFailed
+			decisions[i].Reason = err.Error()
+			report.Failed++
+			report.Errors = append(report.Errors, d.Kind+" "+d.ReferenceID+": "+err.Error())
+			continue
+		}
+		report.Released++
+	}
+
+	if data, err := json.Marshal(report); err == nil {
+		rdb.Set(ctx, lastRunKey, data, 0)
+	}
+
+	return report, nil
+}
+
+func LastRun(ctx context.Context, rdb *redis.Client) (*Report, error) {
+	data, err := rdb.Get(ctx, lastRunKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+var renewLeader = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+var releaseLeader = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Scheduler runs on every gateway replica, but only the one holding the
+// leader lock acts on a tick. The lock outlives a couple of ticks so a
+// replica that dies hands over after at most lockTTL.
+type Scheduler struct {
+	rdb      *redis.Client
+	backend  Backend
+	defaults Rules
+	interval time.Duration
+	lockTTL  time.Duration
+	id       string
+}
+
+func NewScheduler(rdb *redis.Client, backend Backend, defaults Rules, interval time.Duration) *Scheduler {
+	host, _ := os.Hostname()
+	return &Scheduler{
+		rdb:      rdb,
+		backend:  backend,
+		defaults: defaults,
+		interval: interval,
+		lockTTL:  2*interval + 30*time.Second,
+		id:       host + "-" + strconv.Itoa(os.Getpid()),
+	}
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				releaseLeader.Run(context.Background(), s.rdb, []string{leaderKey}, s.id)
+				return
+			case <-ticker.C:
+				s.tick(ctx)
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	leader, err := s.lead(ctx)
+	if err != nil {
+		log.Println("Escrow scheduler: leader election failed:", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	rules, err := LoadRules(ctx, s.rdb, s.defaults)
+	if err != nil {
+		log.Println("Escrow scheduler: failed to load rules:", err)
+		return
+	}
+	if !rules.Enabled {
+		return
+	}
+
+	report, err := Run(ctx, s.rdb, s.backend, rules)
+	if err != nil {
+		log.Println("Escrow scheduler: run failed:", err)
+		return
+	}
+	if report.Released > 0 || report.Failed > 0 {
+		log.Printf("Escrow scheduler: requested %d releases, %d failed", report.Released, report.Failed)
+	}
+}
+
+func (s *Scheduler) lead(ctx context.Context) (bool, error) {
+	acquired, err := s.rdb.SetNX(ctx, leaderKey, s.id, s.lockTTL).Result()
+	if err != nil || acquired {
+		return acquired, err
+	}
+
+	renewed, err := renewLeader.Run(ctx, s.rdb, []string{leaderKey}, s.id, s.lockTTL.Milliseconds()).Int()
+	return renewed == 1, err
+}
@@ -0,0 +1,162 @@
+package escrow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
+)
+
+type fakeStore struct {
+	requested map[string]bool
+	disputed  map[string]bool
+	decisions map[string]string
+	refunded  map[string]bool
+	err       error
+}
+
+func (s fakeStore) Requested(ctx context.Context, kind, referenceID string) (bool, error) {
+	return s.requested[requestedField(kind, referenceID)], s.err
+}
+
+func (s fakeStore) Disputed(ctx context.Context, bookingID string) (bool, error) {
+	return s.disputed[bookingID], s.err
+}
+
+func (s fakeStore) DisputeDecision(ctx context.Context, bookingID string) (string, error) {
+	return s.decisions[bookingID], s.err
+}
+
+func (s fakeStore) Refunded(ctx context.Context, kind, referenceID string) (bool, error) {
+	return s.refunded[kind+":"+referenceID], s.err
+}
+
+type fakeBackend struct {
+	candidates []Candidate
+	err        error
+}
+
+func (b fakeBackend) Candidates(ctx context.Context) ([]Candidate, error) {
+	return b.candidates, b.err
+}
+
+func (b fakeBackend) RequestRelease(ctx context.Context, c Candidate) error {
+	return nil
+}
+
+func TestSkipReason(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Hour)
+
+	rules := Rules{Enabled: true, MaxAmount: 50000, ExcludedVendors: []string{"vendor-x"}}
+	booking := Candidate{Kind: KindBooking, ReferenceID: "b1", VendorID: "vendor-1", Amount: 1000}
+	event := Candidate{Kind: KindEvent, ReferenceID: "e1", Amount: 1000, BookingIDs: []string{"b2", "b3"}, TicketIDs: []string{"t1"}}
+
+	tests := []struct {
+		name      string
+		store     fakeStore
+		rules     Rules
+		candidate Candidate
+		due       time.Time
+		want      string
+	}{
+		{name: "due booking is released", candidate: booking, due: due, want: ""},
+		{name: "due event is released", candidate: event, due: due, want: ""},
+		{name: "already requested", store: fakeStore{requested: map[string]bool{"booking:b1": true}}, candidate: booking, due: due, want: "release already requested"},
+		{name: "request for another kind does not count", store: fakeStore{requested: map[string]bool{"event:b1": true}}, candidate: booking, due: due, want: ""},
+		{name: "excluded vendor", candidate: Candidate{Kind: KindBooking, ReferenceID: "b1", VendorID: "vendor-x", Amount: 1000}, due: due, want: "vendor is excluded from auto release"},
+		{name: "above the limit", candidate: Candidate{Kind: KindBooking, ReferenceID: "b1", Amount: 50001}, due: due, want: "amount is above the auto release limit"},
+		{name: "no limit", rules: Rules{Enabled: true}, candidate: Candidate{Kind: KindBooking, ReferenceID: "b1", Amount: 1 << 40}, due: due, want: ""},
+		{name: "not due yet", candidate: booking, due: now.Add(time.Minute), want: "not due yet"},
+		{name: "due exactly now", candidate: booking, due: now, want: ""},
+		{name: "booking is disputed", store: fakeStore{disputed: map[string]bool{"b1": true}}, candidate: booking, due: due, want: "booking b1 is disputed"},
+		{name: "event booking is disputed", store: fakeStore{disputed: map[string]bool{"b3": true}}, candidate: event, due: due, want: "booking b3 is disputed"},
+		{name: "refund decision holds booking", store: fakeStore{decisions: map[string]string{"b1": dispute.DecisionRefund}}, candidate: booking, due: due, want: "booking b1 was refunded in a dispute"},
+		{name: "release decision does not hold", store: fakeStore{decisions: map[string]string{"b1": dispute.DecisionRelease}}, candidate: booking, due: due, want: ""},
+		{name: "booking has a refund", store: fakeStore{refunded: map[string]bool{refund.KindBooking + ":b1": true}}, candidate: booking, due: due, want: "booking b1 has a refund"},
+		{name: "event booking has a refund", store: fakeStore{refunded: map[string]bool{refund.KindBooking + ":b2": true}}, candidate: event, due: due, want: "booking b2 has a refund"},
+		{name: "event ticket has a refund", store: fakeStore{refunded: map[string]bool{refund.KindTicket + ":t1": true}}, candidate: event, due: due, want: "ticket t1 has a refund"},
+		{name: "requested wins over not due", store: fakeStore{requested: map[string]bool{"booking:b1": true}}, candidate: booking, due: now.Add(time.Hour), want: "release already requested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rules
+			if tt.rules.Enabled {
+				r = tt.rules
+			}
+
+			got, err := skipReason(context.Background(), tt.store, r, tt.candidate, tt.due, now)
+			if err != nil {
+				t.Fatalf("skipReason() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("skipReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSkipReasonStoreError(t *testing.T) {
+	storeErr := errors.New("redis down")
+	c := Candidate{Kind: KindBooking, ReferenceID: "b1"}
+
+	_, err := skipReason(context.Background(), fakeStore{err: storeErr}, Rules{}, c, time.Time{}, time.Now())
+	if !errors.Is(err, storeErr) {
+		t.Fatalf("skipReason() error = %v, want %v", err, storeErr)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	rules := Rules{Enabled: true, EventDelayDays: 5, BookingDelayDays: 2}
+
+	backend := fakeBackend{candidates: []Candidate{
+		{Kind: KindBooking, ReferenceID: "b1", CompletedAt: now.Add(-3 * 24 * time.Hour)},
+		{Kind: KindBooking, ReferenceID: "b2", CompletedAt: now.Add(-24 * time.Hour)},
+		{Kind: KindEvent, ReferenceID: "e1", CompletedAt: now.Add(-3 * 24 * time.Hour)},
+		{Kind: KindEvent, ReferenceID: "e2", CompletedAt: now.Add(-6 * 24 * time.Hour), BookingIDs: []string{"b9"}},
+	}}
+	store := fakeStore{disputed: map[string]bool{"b9": true}}
+
+	decisions, err := Plan(context.Background(), store, backend, rules, now)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	want := []struct {
+		action string
+		reason string
+		dueAt  time.Time
+	}{
+		{action: ActionRelease, dueAt: now.Add(-24 * time.Hour)},
+		{action: ActionSkip, reason: "not due yet", dueAt: now.Add(24 * time.Hour)},
+		{action: ActionSkip, reason: "not due yet", dueAt: now.Add(2 * 24 * time.Hour)},
+		{action: ActionSkip, reason: "booking b9 is disputed", dueAt: now.Add(-24 * time.Hour)},
+	}
+	if len(decisions) != len(want) {
+		t.Fatalf("got %d decisions, want %d", len(decisions), len(want))
+	}
+	for i, w := range want {
+		d := decisions[i]
+		if d.Action != w.action || d.Reason != w.reason || d.DueAt != w.dueAt.Format(time.RFC3339) {
+			t.Errorf("decision %d = %s %q due %s, want %s %q due %s", i, d.Action, d.Reason, d.DueAt, w.action, w.reason, w.dueAt.Format(time.RFC3339))
+		}
+	}
+}
+
+func TestPlanErrors(t *testing.T) {
+	backendErr := errors.New("admin unavailable")
+	if _, err := Plan(context.Background(), fakeStore{}, fakeBackend{err: backendErr}, Rules{}, time.Now()); !errors.Is(err, backendErr) {
+		t.Fatalf("Plan() error = %v, want %v", err, backendErr)
+	}
+
+	storeErr := errors.New("redis down")
+	backend := fakeBackend{candidates: []Candidate{{Kind: KindBooking, ReferenceID: "b1"}}}
+	if _, err := Plan(context.Background(), fakeStore{err: storeErr}, backend, Rules{}, time.Now()); !errors.Is(err, storeErr) {
+		t.Fatalf("Plan() error = %v, want %v", err, storeErr)
+	}
+}
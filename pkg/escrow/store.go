@@ -0,0 +1,76 @@
+package escrow
+
+import (
+	"context"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/dispute"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/refund"
+	"github.com/redis/go-redis/v9"
+)
+
+// requestedKey holds one field per release the scheduler asked for. A
+// field stays for as long as the admin service still lists the funds as
+// held, so a release awaiting approval is not asked for again.
+const requestedKey = "escrow:requested"
+
+func requestedField(kind, referenceID string) string {
+	return kind + ":" + referenceID
+}
+
+// Store answers what else, besides the rules, keeps funds held: an earlier
+// release request, a dispute or a refund.
+type Store interface {
+	Requested(ctx context.Context, kind, referenceID string) (bool, error)
+	Disputed(ctx context.Context, bookingID string) (bool, error)
+	DisputeDecision(ctx context.Context, bookingID string) (string, error)
+	Refunded(ctx context.Context, kind, referenceID string) (bool, error)
+}
+
+type redisStore struct {
+	rdb *redis.Client
+}
+
+func (s redisStore) Requested(ctx context.Context, kind, referenceID string) (bool, error) {
+	return s.rdb.HExists(ctx, requestedKey, requestedField(kind, referenceID)).Result()
+}
+
+func (s redisStore) Disputed(ctx context.Context, bookingID string) (bool, error) {
+	return dispute.ActiveForBooking(ctx, s.rdb, bookingID)
+}
+
+func (s redisStore) DisputeDecision(ctx context.Context, bookingID string) (string, error) {
+	return dispute.DecisionForBooking(ctx, s.rdb, bookingID)
+}
+
+// Refunded reports a refund that is pending, being processed or succeeded.
+// Rejected and failed refunds release their hold on the booking or ticket.
+func (s redisStore) Refunded(ctx context.Context, kind, referenceID string) (bool, error) {
+	id, err := refund.Existing(ctx, s.rdb, kind, referenceID)
+	return id != "", err
+}
+
+// forgetReleased drops the request markers of funds that are no longer
+// held, keeping the hash the size of the current candidate list.
+func forgetReleased(ctx context.Context, rdb *redis.Client, candidates []Candidate) error {
+	fields, err := rdb.HKeys(ctx, requestedKey).Result()
+	if err != nil {
+		return err
+	}
+
+	held := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		held[requestedField(c.Kind, c.ReferenceID)] = true
+	}
+
+	var released []string
+	for _, field := range fields {
+		if !held[field] {
+			released = append(released, field)
+		}
+	}
+	if len(released) == 0 {
+		return nil
+	}
+
+	return rdb.HDel(ctx, requestedKey, released...).Err()
+}
@@ -0,0 +1,918 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: admin/gateway_admin.proto
+
+package admin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportUserDataRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *structpb.Struct       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportUserDataResponse) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AnonymizeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserRequest) Reset() {
+	*x = AnonymizeUserRequest{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnonymizeUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnonymizeUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AnonymizeUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserResponse) Reset() {
+	*x = AnonymizeUserResponse{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type PaymentEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentEventRequest) Reset() {
+	*x = PaymentEventRequest{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEventRequest) ProtoMessage() {}
+
+func (x *PaymentEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEventRequest.ProtoReflect.Descriptor instead.
+func (*PaymentEventRequest) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PaymentEventRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PaymentEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *PaymentEventRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type PaymentEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentEventResponse) Reset() {
+	*x = PaymentEventResponse{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEventResponse) ProtoMessage() {}
+
+func (x *PaymentEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEventResponse.ProtoReflect.Descriptor instead.
+func (*PaymentEventResponse) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{5}
+}
+
+type RefundRecord struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RefundId         string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	ClientId         string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	ReferenceId      string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Provider         string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	PaymentId        string                 `protobuf:"bytes,6,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	ProviderRefundId string                 `protobuf:"bytes,7,opt,name=provider_refund_id,json=providerRefundId,proto3" json:"provider_refund_id,omitempty"`
+	Amount           int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status           string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RefundRecord) Reset() {
+	*x = RefundRecord{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRecord) ProtoMessage() {}
+
+func (x *RefundRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRecord.ProtoReflect.Descriptor instead.
+func (*RefundRecord) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefundRecord) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetProviderRefundId() string {
+	if x != nil {
+		return x.ProviderRefundId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RecordRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRefundResponse) Reset() {
+	*x = RecordRefundResponse{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRefundResponse) ProtoMessage() {}
+
+func (x *RecordRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRefundResponse.ProtoReflect.Descriptor instead.
+func (*RecordRefundResponse) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type DisputeRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeId     string                 `protobuf:"bytes,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	VendorId      string                 `protobuf:"bytes,4,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Decision      string                 `protobuf:"bytes,6,opt,name=decision,proto3" json:"decision,omitempty"`
+	RefundAmount  int64                  `protobuf:"varint,7,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	RefundId      string                 `protobuf:"bytes,8,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputeRecord) Reset() {
+	*x = DisputeRecord{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputeRecord) ProtoMessage() {}
+
+func (x *DisputeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputeRecord.ProtoReflect.Descriptor instead.
+func (*DisputeRecord) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DisputeRecord) GetDisputeId() string {
+	if x != nil {
+		return x.DisputeId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *DisputeRecord) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+type RecordDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordDisputeResponse) Reset() {
+	*x = RecordDisputeResponse{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDisputeResponse) ProtoMessage() {}
+
+func (x *RecordDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDisputeResponse.ProtoReflect.Descriptor instead.
+func (*RecordDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{9}
+}
+
+type ListHeldFundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHeldFundsRequest) Reset() {
+	*x = ListHeldFundsRequest{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHeldFundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeldFundsRequest) ProtoMessage() {}
+
+func (x *ListHeldFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeldFundsRequest.ProtoReflect.Descriptor instead.
+func (*ListHeldFundsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{10}
+}
+
+// HeldFund is either an event, with the bookings and tickets sold for it,
+// or a single booking.
+type HeldFund struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	VendorId      string                 `protobuf:"bytes,4,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	BookingIds    []string               `protobuf:"bytes,7,rep,name=booking_ids,json=bookingIds,proto3" json:"booking_ids,omitempty"`
+	TicketIds     []string               `protobuf:"bytes,8,rep,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeldFund) Reset() {
+	*x = HeldFund{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeldFund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeldFund) ProtoMessage() {}
+
+func (x *HeldFund) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeldFund.ProtoReflect.Descriptor instead.
+func (*HeldFund) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HeldFund) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *HeldFund) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *HeldFund) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *HeldFund) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *HeldFund) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *HeldFund) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *HeldFund) GetBookingIds() []string {
+	if x != nil {
+		return x.BookingIds
+	}
+	return nil
+}
+
+func (x *HeldFund) GetTicketIds() []string {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+type ListHeldFundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*HeldFund            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHeldFundsResponse) Reset() {
+	*x = ListHeldFundsResponse{}
+	mi := &file_admin_gateway_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHeldFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeldFundsResponse) ProtoMessage() {}
+
+func (x *ListHeldFundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_gateway_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeldFundsResponse.ProtoReflect.Descriptor instead.
+func (*ListHeldFundsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_gateway_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListHeldFundsResponse) GetItems() []*HeldFund {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_admin_gateway_admin_proto protoreflect.FileDescriptor
+
+const file_admin_gateway_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x19admin/gateway_admin.proto\x12\rgateway.admin\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"D\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"E\n" +
+	"\x16ExportUserDataResponse\x12+\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x04data\"C\n" +
+	"\x14AnonymizeUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x17\n" +
+	"\x15AnonymizeUserResponse\"\x85\x01\n" +
+	"\x13PaymentEventRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\"\x16\n" +
+	"\x14PaymentEventResponse\"\x98\x02\n" +
+	"\fRefundRecord\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x06 \x01(\tR\tpaymentId\x12,\n" +
+	"\x12provider_refund_id\x18\a \x01(\tR\x10providerRefundId\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"\x16\n" +
+	"\x14RecordRefundResponse\"\xfd\x01\n" +
+	"\rDisputeRecord\x12\x1d\n" +
+	"\n" +
+	"dispute_id\x18\x01 \x01(\tR\tdisputeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tvendor_id\x18\x04 \x01(\tR\bvendorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\bdecision\x18\x06 \x01(\tR\bdecision\x12#\n" +
+	"\rrefund_amount\x18\a \x01(\x03R\frefundAmount\x12\x1b\n" +
+	"\trefund_id\x18\b \x01(\tR\brefundId\"\x17\n" +
+	"\x15RecordDisputeResponse\"\x16\n" +
+	"\x14ListHeldFundsRequest\"\x92\x02\n" +
+	"\bHeldFund\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tvendor_id\x18\x04 \x01(\tR\bvendorId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x1f\n" +
+	"\vbooking_ids\x18\a \x03(\tR\n" +
+	"bookingIds\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\b \x03(\tR\tticketIds\"F\n" +
+	"\x15ListHeldFundsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.gateway.admin.HeldFundR\x05items2\xb1\x04\n" +
+	"\x13AdminGatewayService\x12]\n" +
+	"\x0eExportUserData\x12$.gateway.admin.ExportUserDataRequest\x1a%.gateway.admin.ExportUserDataResponse\x12Z\n" +
+	"\rAnonymizeUser\x12#.gateway.admin.AnonymizeUserRequest\x1a$.gateway.admin.AnonymizeUserResponse\x12\\\n" +
+	"\x11HandleStripeEvent\x12\".gateway.admin.PaymentEventRequest\x1a#.gateway.admin.PaymentEventResponse\x12P\n" +
+	"\fRecordRefund\x12\x1b.gateway.admin.RefundRecord\x1a#.gateway.admin.RecordRefundResponse\x12S\n" +
+	"\rRecordDispute\x12\x1c.gateway.admin.DisputeRecord\x1a$.gateway.admin.RecordDisputeResponse\x12Z\n" +
+	"\rListHeldFunds\x12#.gateway.admin.ListHeldFundsRequest\x1a$.gateway.admin.ListHeldFundsResponseBBZ@github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/adminb\x06proto3"
+
+var (
+	file_admin_gateway_admin_proto_rawDescOnce sync.Once
+	file_admin_gateway_admin_proto_rawDescData []byte
+)
+
+func file_admin_gateway_admin_proto_rawDescGZIP() []byte {
+	file_admin_gateway_admin_proto_rawDescOnce.Do(func() {
+		file_admin_gateway_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_gateway_admin_proto_rawDesc), len(file_admin_gateway_admin_proto_rawDesc)))
+	})
+	return file_admin_gateway_admin_proto_rawDescData
+}
+
+var file_admin_gateway_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_admin_gateway_admin_proto_goTypes = []any{
+	(*ExportUserDataRequest)(nil),  // 0: gateway.admin.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil), // 1: gateway.admin.ExportUserDataResponse
+	(*AnonymizeUserRequest)(nil),   // 2: gateway.admin.AnonymizeUserRequest
+	(*AnonymizeUserResponse)(nil),  // 3: gateway.admin.AnonymizeUserResponse
+	(*PaymentEventRequest)(nil),    // 4: gateway.admin.PaymentEventRequest
+	(*PaymentEventResponse)(nil),   // 5: gateway.admin.PaymentEventResponse
+	(*RefundRecord)(nil),           // 6: gateway.admin.RefundRecord
+	(*RecordRefundResponse)(nil),   // 7: gateway.admin.RecordRefundResponse
+	(*DisputeRecord)(nil),          // 8: gateway.admin.DisputeRecord
+	(*RecordDisputeResponse)(nil),  // 9: gateway.admin.RecordDisputeResponse
+	(*ListHeldFundsRequest)(nil),   // 10: gateway.admin.ListHeldFundsRequest
+	(*HeldFund)(nil),               // 11: gateway.admin.HeldFund
+	(*ListHeldFundsResponse)(nil),  // 12: gateway.admin.ListHeldFundsResponse
+	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
+}
+var file_admin_gateway_admin_proto_depIdxs = []int32{
+	13, // 0: gateway.admin.ExportUserDataResponse.data:type_name -> google.protobuf.Struct
+	14, // 1: gateway.admin.HeldFund.completed_at:type_name -> google.protobuf.Timestamp
+	11, // 2: gateway.admin.ListHeldFundsResponse.items:type_name -> gateway.admin.HeldFund
+	0,  // 3: gateway.admin.AdminGatewayService.ExportUserData:input_type -> gateway.admin.ExportUserDataRequest
+	2,  // 4: gateway.admin.AdminGatewayService.AnonymizeUser:input_type -> gateway.admin.AnonymizeUserRequest
+	4,  // 5: gateway.admin.AdminGatewayService.HandleStripeEvent:input_type -> gateway.admin.PaymentEventRequest
+	6,  // 6: gateway.admin.AdminGatewayService.RecordRefund:input_type -> gateway.admin.RefundRecord
+	8,  // 7: gateway.admin.AdminGatewayService.RecordDispute:input_type -> gateway.admin.DisputeRecord
+	10, // 8: gateway.admin.AdminGatewayService.ListHeldFunds:input_type -> gateway.admin.ListHeldFundsRequest
+	1,  // 9: gateway.admin.AdminGatewayService.ExportUserData:output_type -> gateway.admin.ExportUserDataResponse
+	3,  // 10: gateway.admin.AdminGatewayService.AnonymizeUser:output_type -> gateway.admin.AnonymizeUserResponse
+	5,  // 11: gateway.admin.AdminGatewayService.HandleStripeEvent:output_type -> gateway.admin.PaymentEventResponse
+	7,  // 12: gateway.admin.AdminGatewayService.RecordRefund:output_type -> gateway.admin.RecordRefundResponse
+	9,  // 13: gateway.admin.AdminGatewayService.RecordDispute:output_type -> gateway.admin.RecordDisputeResponse
+	12, // 14: gateway.admin.AdminGatewayService.ListHeldFunds:output_type -> gateway.admin.ListHeldFundsResponse
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_admin_gateway_admin_proto_init() }
+func file_admin_gateway_admin_proto_init() {
+	if File_admin_gateway_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_gateway_admin_proto_rawDesc), len(file_admin_gateway_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_gateway_admin_proto_goTypes,
+		DependencyIndexes: file_admin_gateway_admin_proto_depIdxs,
+		MessageInfos:      file_admin_gateway_admin_proto_msgTypes,
+	}.Build()
+	File_admin_gateway_admin_proto = out.File
+	file_admin_gateway_admin_proto_goTypes = nil
+	file_admin_gateway_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: admin/gateway_admin.proto
+
+package admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminGatewayService_ExportUserData_FullMethodName    = "/gateway.admin.AdminGatewayService/ExportUserData"
+	AdminGatewayService_AnonymizeUser_FullMethodName     = "/gateway.admin.AdminGatewayService/AnonymizeUser"
+	AdminGatewayService_HandleStripeEvent_FullMethodName = "/gateway.admin.AdminGatewayService/HandleStripeEvent"
+	AdminGatewayService_RecordRefund_FullMethodName      = "/gateway.admin.AdminGatewayService/RecordRefund"
+	AdminGatewayService_RecordDispute_FullMethodName     = "/gateway.admin.AdminGatewayService/RecordDispute"
+	AdminGatewayService_ListHeldFunds_FullMethodName     = "/gateway.admin.AdminGatewayService/ListHeldFunds"
+)
+
+// AdminGatewayServiceClient is the client API for AdminGatewayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminGatewayService is implemented by the admin service alongside
+// admin.AdminService.
+type AdminGatewayServiceClient interface {
+	// ExportUserData returns moderation records kept about a user.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// AnonymizeUser must be idempotent, since a failed deletion is retried
+	// from the start.
+	AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error)
+	// HandleStripeEvent applies a verified Stripe payout, transfer or balance
+	// webhook. It must be idempotent on event_id, since webhooks are retried.
+	HandleStripeEvent(ctx context.Context, in *PaymentEventRequest, opts ...grpc.CallOption) (*PaymentEventResponse, error)
+	// RecordRefund updates the admin wallet with a refund's outcome. It must
+	// be idempotent on refund_id, since recording is retried.
+	RecordRefund(ctx context.Context, in *RefundRecord, opts ...grpc.CallOption) (*RecordRefundResponse, error)
+	// RecordDispute keeps the admin service's copy of a dispute current and
+	// carries out release decisions. It is sent on every transition and must
+	// accept the same state more than once.
+	RecordDispute(ctx context.Context, in *DisputeRecord, opts ...grpc.CallOption) (*RecordDisputeResponse, error)
+	// ListHeldFunds returns the money still held for finished events and
+	// completed bookings, including funds whose release is awaiting approval.
+	ListHeldFunds(ctx context.Context, in *ListHeldFundsRequest, opts ...grpc.CallOption) (*ListHeldFundsResponse, error)
+}
+
+type adminGatewayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminGatewayServiceClient(cc grpc.ClientConnInterface) AdminGatewayServiceClient {
+	return &adminGatewayServiceClient{cc}
+}
+
+func (c *adminGatewayServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_AnonymizeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) HandleStripeEvent(ctx context.Context, in *PaymentEventRequest, opts ...grpc.CallOption) (*PaymentEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentEventResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_HandleStripeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) RecordRefund(ctx context.Context, in *RefundRecord, opts ...grpc.CallOption) (*RecordRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordRefundResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_RecordRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) RecordDispute(ctx context.Context, in *DisputeRecord, opts ...grpc.CallOption) (*RecordDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordDisputeResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_RecordDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminGatewayServiceClient) ListHeldFunds(ctx context.Context, in *ListHeldFundsRequest, opts ...grpc.CallOption) (*ListHeldFundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHeldFundsResponse)
+	err := c.cc.Invoke(ctx, AdminGatewayService_ListHeldFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminGatewayServiceServer is the server API for AdminGatewayService service.
+// All implementations must embed UnimplementedAdminGatewayServiceServer
+// for forward compatibility.
+//
+// AdminGatewayService is implemented by the admin service alongside
+// admin.AdminService.
+type AdminGatewayServiceServer interface {
+	// ExportUserData returns moderation records kept about a user.
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// AnonymizeUser must be idempotent, since a failed deletion is retried
+	// from the start.
+	AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error)
+	// HandleStripeEvent applies a verified Stripe payout, transfer or balance
+	// webhook. It must be idempotent on event_id, since webhooks are retried.
+	HandleStripeEvent(context.Context, *PaymentEventRequest) (*PaymentEventResponse, error)
+	// RecordRefund updates the admin wallet with a refund's outcome. It must
+	// be idempotent on refund_id, since recording is retried.
+	RecordRefund(context.Context, *RefundRecord) (*RecordRefundResponse, error)
+	// RecordDispute keeps the admin service's copy of a dispute current and
+	// carries out release decisions. It is sent on every transition and must
+	// accept the same state more than once.
+	RecordDispute(context.Context, *DisputeRecord) (*RecordDisputeResponse, error)
+	// ListHeldFunds returns the money still held for finished events and
+	// completed bookings, including funds whose release is awaiting approval.
+	ListHeldFunds(context.Context, *ListHeldFundsRequest) (*ListHeldFundsResponse, error)
+	mustEmbedUnimplementedAdminGatewayServiceServer()
+}
+
+// UnimplementedAdminGatewayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminGatewayServiceServer struct{}
+
+func (UnimplementedAdminGatewayServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUser not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) HandleStripeEvent(context.Context, *PaymentEventRequest) (*PaymentEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleStripeEvent not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) RecordRefund(context.Context, *RefundRecord) (*RecordRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRefund not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) RecordDispute(context.Context, *DisputeRecord) (*RecordDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDispute not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) ListHeldFunds(context.Context, *ListHeldFundsRequest) (*ListHeldFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeldFunds not implemented")
+}
+func (UnimplementedAdminGatewayServiceServer) mustEmbedUnimplementedAdminGatewayServiceServer() {}
+func (UnimplementedAdminGatewayServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAdminGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminGatewayServiceServer will
+// result in compilation errors.
+type UnsafeAdminGatewayServiceServer interface {
+	mustEmbedUnimplementedAdminGatewayServiceServer()
+}
+
+func RegisterAdminGatewayServiceServer(s grpc.ServiceRegistrar, srv AdminGatewayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminGatewayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminGatewayService_ServiceDesc, srv)
+}
+
+func _AdminGatewayService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_AnonymizeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).AnonymizeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_AnonymizeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).AnonymizeUser(ctx, req.(*AnonymizeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_HandleStripeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).HandleStripeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_HandleStripeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).HandleStripeEvent(ctx, req.(*PaymentEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_RecordRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).RecordRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_RecordRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).RecordRefund(ctx, req.(*RefundRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_RecordDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisputeRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).RecordDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_RecordDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).RecordDispute(ctx, req.(*DisputeRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminGatewayService_ListHeldFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHeldFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGatewayServiceServer).ListHeldFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminGatewayService_ListHeldFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGatewayServiceServer).ListHeldFunds(ctx, req.(*ListHeldFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminGatewayService_ServiceDesc is the grpc.ServiceDesc for AdminGatewayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminGatewayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gateway.admin.AdminGatewayService",
+	HandlerType: (*AdminGatewayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUserData",
+			Handler:    _AdminGatewayService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "AnonymizeUser",
+			Handler:    _AdminGatewayService_AnonymizeUser_Handler,
+		},
+		{
+			MethodName: "HandleStripeEvent",
+			Handler:    _AdminGatewayService_HandleStripeEvent_Handler,
+		},
+		{
+			MethodName: "RecordRefund",
+			Handler:    _AdminGatewayService_RecordRefund_Handler,
+		},
+		{
+			MethodName: "RecordDispute",
+			Handler:    _AdminGatewayService_RecordDispute_Handler,
+		},
+		{
+			MethodName: "ListHeldFunds",
+			Handler:    _AdminGatewayService_ListHeldFunds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/gateway_admin.proto",
+}
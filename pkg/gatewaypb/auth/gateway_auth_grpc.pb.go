@@ -0,0 +1,623 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: auth/gateway_auth.proto
+
+package auth
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthGatewayService_GoogleCallback_FullMethodName          = "/gateway.auth.AuthGatewayService/GoogleCallback"
+	AuthGatewayService_ExternalLogin_FullMethodName           = "/gateway.auth.AuthGatewayService/ExternalLogin"
+	AuthGatewayService_PasskeyLogin_FullMethodName            = "/gateway.auth.AuthGatewayService/PasskeyLogin"
+	AuthGatewayService_SendPasswordResetOTP_FullMethodName    = "/gateway.auth.AuthGatewayService/SendPasswordResetOTP"
+	AuthGatewayService_VerifyPasswordResetOTP_FullMethodName  = "/gateway.auth.AuthGatewayService/VerifyPasswordResetOTP"
+	AuthGatewayService_ForceResetPassword_FullMethodName      = "/gateway.auth.AuthGatewayService/ForceResetPassword"
+	AuthGatewayService_GetAccount_FullMethodName              = "/gateway.auth.AuthGatewayService/GetAccount"
+	AuthGatewayService_VerifyPassword_FullMethodName          = "/gateway.auth.AuthGatewayService/VerifyPassword"
+	AuthGatewayService_IsCurrentPassword_FullMethodName       = "/gateway.auth.AuthGatewayService/IsCurrentPassword"
+	AuthGatewayService_ChangeEmail_FullMethodName             = "/gateway.auth.AuthGatewayService/ChangeEmail"
+	AuthGatewayService_IssueImpersonationToken_FullMethodName = "/gateway.auth.AuthGatewayService/IssueImpersonationToken"
+	AuthGatewayService_ExportUserData_FullMethodName          = "/gateway.auth.AuthGatewayService/ExportUserData"
+	AuthGatewayService_AnonymizeUser_FullMethodName           = "/gateway.auth.AuthGatewayService/AnonymizeUser"
+)
+
+// AuthGatewayServiceClient is the client API for AuthGatewayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuthGatewayService is implemented by the auth service alongside
+// auth.AuthService.
+type AuthGatewayServiceClient interface {
+	// GoogleCallback exchanges a Google authorization code. code_verifier is
+	// the PKCE verifier for the code and is empty when PKCE is disabled.
+	GoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	// ExternalLogin signs in, or signs up, the owner of an identity the
+	// gateway already verified with an OIDC provider.
+	ExternalLogin(ctx context.Context, in *ExternalLoginRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	// PasskeyLogin issues tokens for a user whose passkey assertion the
+	// gateway verified.
+	PasskeyLogin(ctx context.Context, in *PasskeyLoginRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	// SendPasswordResetOTP emails a reset code to an existing account and
+	// returns NOT_FOUND when there is no account for the email and role.
+	SendPasswordResetOTP(ctx context.Context, in *PasswordResetOTPRequest, opts ...grpc.CallOption) (*PasswordResetOTPResponse, error)
+	VerifyPasswordResetOTP(ctx context.Context, in *VerifyPasswordResetOTPRequest, opts ...grpc.CallOption) (*VerifyPasswordResetOTPResponse, error)
+	// ForceResetPassword sets a new password once the gateway has checked the
+	// reset token.
+	ForceResetPassword(ctx context.Context, in *ForceResetPasswordRequest, opts ...grpc.CallOption) (*ForceResetPasswordResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// VerifyPassword re-authenticates a signed-in user before a sensitive
+	// account change. It returns UNAUTHENTICATED for a wrong password.
+	VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error)
+	// IsCurrentPassword reports whether password is the user's current one,
+	// so it can be refused as a new password. Unlike VerifyPassword it must
+	// not count as a failed sign-in attempt.
+	IsCurrentPassword(ctx context.Context, in *IsCurrentPasswordRequest, opts ...grpc.CallOption) (*IsCurrentPasswordResponse, error)
+	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*ChangeEmailResponse, error)
+	// IssueImpersonationToken signs an access token for user_id that carries
+	// an act claim for admin_id and session_id as its jti, so the auth
+	// service stays the only holder of the signing keys.
+	IssueImpersonationToken(ctx context.Context, in *IssueImpersonationTokenRequest, opts ...grpc.CallOption) (*IssueImpersonationTokenResponse, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// AnonymizeUser runs last in an account deletion and must be idempotent,
+	// since a failed deletion is retried from the start.
+	AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error)
+}
+
+type authGatewayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthGatewayServiceClient(cc grpc.ClientConnInterface) AuthGatewayServiceClient {
+	return &authGatewayServiceClient{cc}
+}
+
+func (c *authGatewayServiceClient) GoogleCallback(ctx context.Context, in *GoogleCallbackRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_GoogleCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) ExternalLogin(ctx context.Context, in *ExternalLoginRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_ExternalLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) PasskeyLogin(ctx context.Context, in *PasskeyLoginRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_PasskeyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) SendPasswordResetOTP(ctx context.Context, in *PasswordResetOTPRequest, opts ...grpc.CallOption) (*PasswordResetOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordResetOTPResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_SendPasswordResetOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) VerifyPasswordResetOTP(ctx context.Context, in *VerifyPasswordResetOTPRequest, opts ...grpc.CallOption) (*VerifyPasswordResetOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPasswordResetOTPResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_VerifyPasswordResetOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) ForceResetPassword(ctx context.Context, in *ForceResetPasswordRequest, opts ...grpc.CallOption) (*ForceResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceResetPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_ForceResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, AuthGatewayService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) VerifyPassword(ctx context.Context, in *VerifyPasswordRequest, opts ...grpc.CallOption) (*VerifyPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_VerifyPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) IsCurrentPassword(ctx context.Context, in *IsCurrentPasswordRequest, opts ...grpc.CallOption) (*IsCurrentPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsCurrentPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_IsCurrentPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*ChangeEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeEmailResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_ChangeEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) IssueImpersonationToken(ctx context.Context, in *IssueImpersonationTokenRequest, opts ...grpc.CallOption) (*IssueImpersonationTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueImpersonationTokenResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_IssueImpersonationToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authGatewayServiceClient) AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserResponse)
+	err := c.cc.Invoke(ctx, AuthGatewayService_AnonymizeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthGatewayServiceServer is the server API for AuthGatewayService service.
+// All implementations must embed UnimplementedAuthGatewayServiceServer
+// for forward compatibility.
+//
+// AuthGatewayService is implemented by the auth service alongside
+// auth.AuthService.
+type AuthGatewayServiceServer interface {
+	// GoogleCallback exchanges a Google authorization code. code_verifier is
+	// the PKCE verifier for the code and is empty when PKCE is disabled.
+	GoogleCallback(context.Context, *GoogleCallbackRequest) (*TokenResponse, error)
+	// ExternalLogin signs in, or signs up, the owner of an identity the
+	// gateway already verified with an OIDC provider.
+	ExternalLogin(context.Context, *ExternalLoginRequest) (*TokenResponse, error)
+	// PasskeyLogin issues tokens for a user whose passkey assertion the
+	// gateway verified.
+	PasskeyLogin(context.Context, *PasskeyLoginRequest) (*TokenResponse, error)
+	// SendPasswordResetOTP emails a reset code to an existing account and
+	// returns NOT_FOUND when there is no account for the email and role.
+	SendPasswordResetOTP(context.Context, *PasswordResetOTPRequest) (*PasswordResetOTPResponse, error)
+	VerifyPasswordResetOTP(context.Context, *VerifyPasswordResetOTPRequest) (*VerifyPasswordResetOTPResponse, error)
+	// ForceResetPassword sets a new password once the gateway has checked the
+	// reset token.
+	ForceResetPassword(context.Context, *ForceResetPasswordRequest) (*ForceResetPasswordResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	// VerifyPassword re-authenticates a signed-in user before a sensitive
+	// account change. It returns UNAUTHENTICATED for a wrong password.
+	VerifyPassword(context.Context, *VerifyPasswordRequest) (*VerifyPasswordResponse, error)
+	// IsCurrentPassword reports whether password is the user's current one,
+	// so it can be refused as a new password. Unlike VerifyPassword it must
+	// not count as a failed sign-in attempt.
+	IsCurrentPassword(context.Context, *IsCurrentPasswordRequest) (*IsCurrentPasswordResponse, error)
+	ChangeEmail(context.Context, *ChangeEmailRequest) (*ChangeEmailResponse, error)
+	// IssueImpersonationToken signs an access token for user_id that carries
+	// an act claim for admin_id and session_id as its jti, so the auth
+	// service stays the only holder of the signing keys.
+	IssueImpersonationToken(context.Context, *IssueImpersonationTokenRequest) (*IssueImpersonationTokenResponse, error)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// AnonymizeUser runs last in an account deletion and must be idempotent,
+	// since a failed deletion is retried from the start.
+	AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error)
+	mustEmbedUnimplementedAuthGatewayServiceServer()
+}
+
+// UnimplementedAuthGatewayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthGatewayServiceServer struct{}
+
+func (UnimplementedAuthGatewayServiceServer) GoogleCallback(context.Context, *GoogleCallbackRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoogleCallback not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) ExternalLogin(context.Context, *ExternalLoginRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalLogin not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) PasskeyLogin(context.Context, *PasskeyLoginRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasskeyLogin not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) SendPasswordResetOTP(context.Context, *PasswordResetOTPRequest) (*PasswordResetOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPasswordResetOTP not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) VerifyPasswordResetOTP(context.Context, *VerifyPasswordResetOTPRequest) (*VerifyPasswordResetOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPasswordResetOTP not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) ForceResetPassword(context.Context, *ForceResetPasswordRequest) (*ForceResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceResetPassword not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) VerifyPassword(context.Context, *VerifyPasswordRequest) (*VerifyPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPassword not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) IsCurrentPassword(context.Context, *IsCurrentPasswordRequest) (*IsCurrentPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsCurrentPassword not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) ChangeEmail(context.Context, *ChangeEmailRequest) (*ChangeEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) IssueImpersonationToken(context.Context, *IssueImpersonationTokenRequest) (*IssueImpersonationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueImpersonationToken not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUser not implemented")
+}
+func (UnimplementedAuthGatewayServiceServer) mustEmbedUnimplementedAuthGatewayServiceServer() {}
+func (UnimplementedAuthGatewayServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAuthGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthGatewayServiceServer will
+// result in compilation errors.
+type UnsafeAuthGatewayServiceServer interface {
+	mustEmbedUnimplementedAuthGatewayServiceServer()
+}
+
+func RegisterAuthGatewayServiceServer(s grpc.ServiceRegistrar, srv AuthGatewayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthGatewayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthGatewayService_ServiceDesc, srv)
+}
+
+func _AuthGatewayService_GoogleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoogleCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).GoogleCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_GoogleCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).GoogleCallback(ctx, req.(*GoogleCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_ExternalLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).ExternalLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_ExternalLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).ExternalLogin(ctx, req.(*ExternalLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_PasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasskeyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).PasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_PasskeyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).PasskeyLogin(ctx, req.(*PasskeyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_SendPasswordResetOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).SendPasswordResetOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_SendPasswordResetOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).SendPasswordResetOTP(ctx, req.(*PasswordResetOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_VerifyPasswordResetOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPasswordResetOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).VerifyPasswordResetOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_VerifyPasswordResetOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).VerifyPasswordResetOTP(ctx, req.(*VerifyPasswordResetOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_ForceResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).ForceResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_ForceResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).ForceResetPassword(ctx, req.(*ForceResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_VerifyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).VerifyPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_VerifyPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).VerifyPassword(ctx, req.(*VerifyPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_IsCurrentPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsCurrentPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).IsCurrentPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_IsCurrentPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).IsCurrentPassword(ctx, req.(*IsCurrentPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_ChangeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).ChangeEmail(ctx, req.(*ChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_IssueImpersonationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueImpersonationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).IssueImpersonationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_IssueImpersonationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).IssueImpersonationToken(ctx, req.(*IssueImpersonationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthGatewayService_AnonymizeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthGatewayServiceServer).AnonymizeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthGatewayService_AnonymizeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthGatewayServiceServer).AnonymizeUser(ctx, req.(*AnonymizeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthGatewayService_ServiceDesc is the grpc.ServiceDesc for AuthGatewayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthGatewayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gateway.auth.AuthGatewayService",
+	HandlerType: (*AuthGatewayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GoogleCallback",
+			Handler:    _AuthGatewayService_GoogleCallback_Handler,
+		},
+		{
+			MethodName: "ExternalLogin",
+			Handler:    _AuthGatewayService_ExternalLogin_Handler,
+		},
+		{
+			MethodName: "PasskeyLogin",
+			Handler:    _AuthGatewayService_PasskeyLogin_Handler,
+		},
+		{
+			MethodName: "SendPasswordResetOTP",
+			Handler:    _AuthGatewayService_SendPasswordResetOTP_Handler,
+		},
+		{
+			MethodName: "VerifyPasswordResetOTP",
+			Handler:    _AuthGatewayService_VerifyPasswordResetOTP_Handler,
+		},
+		{
+			MethodName: "ForceResetPassword",
+			Handler:    _AuthGatewayService_ForceResetPassword_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _AuthGatewayService_GetAccount_Handler,
+		},
+		{
+			MethodName: "VerifyPassword",
+			Handler:    _AuthGatewayService_VerifyPassword_Handler,
+		},
+		{
+			MethodName: "IsCurrentPassword",
+			Handler:    _AuthGatewayService_IsCurrentPassword_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _AuthGatewayService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "IssueImpersonationToken",
+			Handler:    _AuthGatewayService_IssueImpersonationToken_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _AuthGatewayService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "AnonymizeUser",
+			Handler:    _AuthGatewayService_AnonymizeUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/gateway_auth.proto",
+}
@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: client/gateway_client.proto
+
+package client
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AnonymizeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserRequest) Reset() {
+	*x = AnonymizeUserRequest{}
+	mi := &file_client_gateway_client_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserRequest) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnonymizeUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnonymizeUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AnonymizeUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserResponse) Reset() {
+	*x = AnonymizeUserResponse{}
+	mi := &file_client_gateway_client_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserResponse) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{1}
+}
+
+type PaymentEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentEventRequest) Reset() {
+	*x = PaymentEventRequest{}
+	mi := &file_client_gateway_client_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEventRequest) ProtoMessage() {}
+
+func (x *PaymentEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEventRequest.ProtoReflect.Descriptor instead.
+func (*PaymentEventRequest) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PaymentEventRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PaymentEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *PaymentEventRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type PaymentEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentEventResponse) Reset() {
+	*x = PaymentEventResponse{}
+	mi := &file_client_gateway_client_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEventResponse) ProtoMessage() {}
+
+func (x *PaymentEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEventResponse.ProtoReflect.Descriptor instead.
+func (*PaymentEventResponse) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{3}
+}
+
+type GetRefundablePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundablePaymentRequest) Reset() {
+	*x = GetRefundablePaymentRequest{}
+	mi := &file_client_gateway_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundablePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundablePaymentRequest) ProtoMessage() {}
+
+func (x *GetRefundablePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundablePaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundablePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRefundablePaymentRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GetRefundablePaymentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetRefundablePaymentRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+type RefundablePayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ServiceAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=service_at,json=serviceAt,proto3" json:"service_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundablePayment) Reset() {
+	*x = RefundablePayment{}
+	mi := &file_client_gateway_client_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundablePayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundablePayment) ProtoMessage() {}
+
+func (x *RefundablePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundablePayment.ProtoReflect.Descriptor instead.
+func (*RefundablePayment) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefundablePayment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RefundablePayment) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundablePayment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundablePayment) GetServiceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServiceAt
+	}
+	return nil
+}
+
+type RefundRecord struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RefundId         string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	ClientId         string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	ReferenceId      string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Provider         string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	PaymentId        string                 `protobuf:"bytes,6,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	ProviderRefundId string                 `protobuf:"bytes,7,opt,name=provider_refund_id,json=providerRefundId,proto3" json:"provider_refund_id,omitempty"`
+	Amount           int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status           string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RefundRecord) Reset() {
+	*x = RefundRecord{}
+	mi := &file_client_gateway_client_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRecord) ProtoMessage() {}
+
+func (x *RefundRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRecord.ProtoReflect.Descriptor instead.
+func (*RefundRecord) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefundRecord) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetProviderRefundId() string {
+	if x != nil {
+		return x.ProviderRefundId
+	}
+	return ""
+}
+
+func (x *RefundRecord) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RecordRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRefundResponse) Reset() {
+	*x = RecordRefundResponse{}
+	mi := &file_client_gateway_client_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRefundResponse) ProtoMessage() {}
+
+func (x *RecordRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRefundResponse.ProtoReflect.Descriptor instead.
+func (*RecordRefundResponse) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{7}
+}
+
+type GetDisputableBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisputableBookingRequest) Reset() {
+	*x = GetDisputableBookingRequest{}
+	mi := &file_client_gateway_client_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisputableBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisputableBookingRequest) ProtoMessage() {}
+
+func (x *GetDisputableBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisputableBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetDisputableBookingRequest) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDisputableBookingRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GetDisputableBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type DisputableBooking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputableBooking) Reset() {
+	*x = DisputableBooking{}
+	mi := &file_client_gateway_client_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputableBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputableBooking) ProtoMessage() {}
+
+func (x *DisputableBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputableBooking.ProtoReflect.Descriptor instead.
+func (*DisputableBooking) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DisputableBooking) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *DisputableBooking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DisputeRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeId     string                 `protobuf:"bytes,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	VendorId      string                 `protobuf:"bytes,4,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Decision      string                 `protobuf:"bytes,6,opt,name=decision,proto3" json:"decision,omitempty"`
+	RefundAmount  int64                  `protobuf:"varint,7,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	RefundId      string                 `protobuf:"bytes,8,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputeRecord) Reset() {
+	*x = DisputeRecord{}
+	mi := &file_client_gateway_client_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputeRecord) ProtoMessage() {}
+
+func (x *DisputeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputeRecord.ProtoReflect.Descriptor instead.
+func (*DisputeRecord) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DisputeRecord) GetDisputeId() string {
+	if x != nil {
+		return x.DisputeId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *DisputeRecord) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *DisputeRecord) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+type RecordDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordDisputeResponse) Reset() {
+	*x = RecordDisputeResponse{}
+	mi := &file_client_gateway_client_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDisputeResponse) ProtoMessage() {}
+
+func (x *RecordDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDisputeResponse.ProtoReflect.Descriptor instead.
+func (*RecordDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{11}
+}
+
+type BookingFundReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingFundReleaseRequest) Reset() {
+	*x = BookingFundReleaseRequest{}
+	mi := &file_client_gateway_client_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingFundReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingFundReleaseRequest) ProtoMessage() {}
+
+func (x *BookingFundReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingFundReleaseRequest.ProtoReflect.Descriptor instead.
+func (*BookingFundReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BookingFundReleaseRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *BookingFundReleaseRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type BookingFundReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingFundReleaseResponse) Reset() {
+	*x = BookingFundReleaseResponse{}
+	mi := &file_client_gateway_client_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingFundReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingFundReleaseResponse) ProtoMessage() {}
+
+func (x *BookingFundReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_gateway_client_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingFundReleaseResponse.ProtoReflect.Descriptor instead.
+func (*BookingFundReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_client_gateway_client_proto_rawDescGZIP(), []int{13}
+}
+
+var File_client_gateway_client_proto protoreflect.FileDescriptor
+
+const file_client_gateway_client_proto_rawDesc = "" +
+	"\n" +
+	"\x1bclient/gateway_client.proto\x12\x0egateway.client\x1a\x1fgoogle/protobuf/timestamp.proto\"C\n" +
+	"\x14AnonymizeUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x17\n" +
+	"\x15AnonymizeUserResponse\"\x85\x01\n" +
+	"\x13PaymentEventRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\"\x16\n" +
+	"\x14PaymentEventResponse\"q\n" +
+	"\x1bGetRefundablePaymentRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\"\xa1\x01\n" +
+	"\x11RefundablePayment\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x129\n" +
+	"\n" +
+	"service_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tserviceAt\"\x98\x02\n" +
+	"\fRefundRecord\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x06 \x01(\tR\tpaymentId\x12,\n" +
+	"\x12provider_refund_id\x18\a \x01(\tR\x10providerRefundId\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"\x16\n" +
+	"\x14RecordRefundResponse\"Y\n" +
+	"\x1bGetDisputableBookingRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\"H\n" +
+	"\x11DisputableBooking\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xfd\x01\n" +
+	"\rDisputeRecord\x12\x1d\n" +
+	"\n" +
+	"dispute_id\x18\x01 \x01(\tR\tdisputeId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tvendor_id\x18\x04 \x01(\tR\bvendorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\bdecision\x18\x06 \x01(\tR\bdecision\x12#\n" +
+	"\rrefund_amount\x18\a \x01(\x03R\frefundAmount\x12\x1b\n" +
+	"\trefund_id\x18\b \x01(\tR\brefundId\"\x17\n" +
+	"\x15RecordDisputeResponse\"W\n" +
+	"\x19BookingFundReleaseRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\"\x1c\n" +
+	"\x1aBookingFundReleaseResponse2\xc5\x05\n" +
+	"\x14ClientGatewayService\x12\\\n" +
+	"\rAnonymizeUser\x12$.gateway.client.AnonymizeUserRequest\x1a%.gateway.client.AnonymizeUserResponse\x12`\n" +
+	"\x13HandleRazorpayEvent\x12#.gateway.client.PaymentEventRequest\x1a$.gateway.client.PaymentEventResponse\x12f\n" +
+	"\x14GetRefundablePayment\x12+.gateway.client.GetRefundablePaymentRequest\x1a!.gateway.client.RefundablePayment\x12R\n" +
+	"\fRecordRefund\x12\x1c.gateway.client.RefundRecord\x1a$.gateway.client.RecordRefundResponse\x12f\n" +
+	"\x14GetDisputableBooking\x12+.gateway.client.GetDisputableBookingRequest\x1a!.gateway.client.DisputableBooking\x12U\n" +
+	"\rRecordDispute\x12\x1d.gateway.client.DisputeRecord\x1a%.gateway.client.RecordDisputeResponse\x12r\n" +
+	"\x19RequestBookingFundRelease\x12).gateway.client.BookingFundReleaseRequest\x1a*.gateway.client.BookingFundReleaseResponseBCZAgithub.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/clientb\x06proto3"
+
+var (
+	file_client_gateway_client_proto_rawDescOnce sync.Once
+	file_client_gateway_client_proto_rawDescData []byte
+)
+
+func file_client_gateway_client_proto_rawDescGZIP() []byte {
+	file_client_gateway_client_proto_rawDescOnce.Do(func() {
+		file_client_gateway_client_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_client_gateway_client_proto_rawDesc), len(file_client_gateway_client_proto_rawDesc)))
+	})
+	return file_client_gateway_client_proto_rawDescData
+}
+
+var file_client_gateway_client_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_client_gateway_client_proto_goTypes = []any{
+	(*AnonymizeUserRequest)(nil),        // 0: gateway.client.AnonymizeUserRequest
+	(*AnonymizeUserResponse)(nil),       // 1: gateway.client.AnonymizeUserResponse
+	(*PaymentEventRequest)(nil),         // 2: gateway.client.PaymentEventRequest
+	(*PaymentEventResponse)(nil),        // 3: gateway.client.PaymentEventResponse
+	(*GetRefundablePaymentRequest)(nil), // 4: gateway.client.GetRefundablePaymentRequest
+	(*RefundablePayment)(nil),           // 5: gateway.client.RefundablePayment
+	(*RefundRecord)(nil),                // 6: gateway.client.RefundRecord
+	(*RecordRefundResponse)(nil),        // 7: gateway.client.RecordRefundResponse
+	(*GetDisputableBookingRequest)(nil), // 8: gateway.client.GetDisputableBookingRequest
+	(*DisputableBooking)(nil),           // 9: gateway.client.DisputableBooking
+	(*DisputeRecord)(nil),               // 10: gateway.client.DisputeRecord
+	(*RecordDisputeResponse)(nil),       // 11: gateway.client.RecordDisputeResponse
+	(*BookingFundReleaseRequest)(nil),   // 12: gateway.client.BookingFundReleaseRequest
+	(*BookingFundReleaseResponse)(nil),  // 13: gateway.client.BookingFundReleaseResponse
+	(*timestamppb.Timestamp)(nil),       // 14: google.protobuf.Timestamp
+}
+var file_client_gateway_client_proto_depIdxs = []int32{
+	14, // 0: gateway.client.RefundablePayment.service_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: gateway.client.ClientGatewayService.AnonymizeUser:input_type -> gateway.client.AnonymizeUserRequest
+	2,  // 2: gateway.client.ClientGatewayService.HandleRazorpayEvent:input_type -> gateway.client.PaymentEventRequest
+	4,  // 3: gateway.client.ClientGatewayService.GetRefundablePayment:input_type -> gateway.client.GetRefundablePaymentRequest
+	6,  // 4: gateway.client.ClientGatewayService.RecordRefund:input_type -> gateway.client.RefundRecord
+	8,  // 5: gateway.client.ClientGatewayService.GetDisputableBooking:input_type -> gateway.client.GetDisputableBookingRequest
+	10, // 6: gateway.client.ClientGatewayService.RecordDispute:input_type -> gateway.client.DisputeRecord
+	12, // 7: gateway.client.ClientGatewayService.RequestBookingFundRelease:input_type -> gateway.client.BookingFundReleaseRequest
+	1,  // 8: gateway.client.ClientGatewayService.AnonymizeUser:output_type -> gateway.client.AnonymizeUserResponse
+	3,  // 9: gateway.client.ClientGatewayService.HandleRazorpayEvent:output_type -> gateway.client.PaymentEventResponse
+	5,  // 10: gateway.client.ClientGatewayService.GetRefundablePayment:output_type -> gateway.client.RefundablePayment
+	7,  // 11: gateway.client.ClientGatewayService.RecordRefund:output_type -> gateway.client.RecordRefundResponse
+	9,  // 12: gateway.client.ClientGatewayService.GetDisputableBooking:output_type -> gateway.client.DisputableBooking
+	11, // 13: gateway.client.ClientGatewayService.RecordDispute:output_type -> gateway.client.RecordDisputeResponse
+	13, // 14: gateway.client.ClientGatewayService.RequestBookingFundRelease:output_type -> gateway.client.BookingFundReleaseResponse
+	8,  // [8:15] is the sub-list for method output_type
+	1,  // [1:8] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_client_gateway_client_proto_init() }
+func file_client_gateway_client_proto_init() {
+	if File_client_gateway_client_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_client_gateway_client_proto_rawDesc), len(file_client_gateway_client_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_client_gateway_client_proto_goTypes,
+		DependencyIndexes: file_client_gateway_client_proto_depIdxs,
+		MessageInfos:      file_client_gateway_client_proto_msgTypes,
+	}.Build()
+	File_client_gateway_client_proto = out.File
+	file_client_gateway_client_proto_goTypes = nil
+	file_client_gateway_client_proto_depIdxs = nil
+}
@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: client/gateway_client.proto
+
+package client
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClientGatewayService_AnonymizeUser_FullMethodName             = "/gateway.client.ClientGatewayService/AnonymizeUser"
+	ClientGatewayService_HandleRazorpayEvent_FullMethodName       = "/gateway.client.ClientGatewayService/HandleRazorpayEvent"
+	ClientGatewayService_GetRefundablePayment_FullMethodName      = "/gateway.client.ClientGatewayService/GetRefundablePayment"
+	ClientGatewayService_RecordRefund_FullMethodName              = "/gateway.client.ClientGatewayService/RecordRefund"
+	ClientGatewayService_GetDisputableBooking_FullMethodName      = "/gateway.client.ClientGatewayService/GetDisputableBooking"
+	ClientGatewayService_RecordDispute_FullMethodName             = "/gateway.client.ClientGatewayService/RecordDispute"
+	ClientGatewayService_RequestBookingFundRelease_FullMethodName = "/gateway.client.ClientGatewayService/RequestBookingFundRelease"
+)
+
+// ClientGatewayServiceClient is the client API for ClientGatewayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ClientGatewayService is implemented by the client service alongside
+// client.ClientService.
+type ClientGatewayServiceClient interface {
+	// AnonymizeUser removes a deleted client's personal data. It must be
+	// idempotent, since a failed deletion is retried from the start.
+	AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error)
+	// HandleRazorpayEvent applies a verified Razorpay webhook, or the
+	// payment.verified event the gateway sends after a checkout callback. It
+	// must be idempotent on event_id, since webhooks are retried.
+	HandleRazorpayEvent(ctx context.Context, in *PaymentEventRequest, opts ...grpc.CallOption) (*PaymentEventResponse, error)
+	// GetRefundablePayment returns the charge behind a client's booking or
+	// ticket. It returns NOT_FOUND when the item is not the client's or was
+	// never paid for.
+	GetRefundablePayment(ctx context.Context, in *GetRefundablePaymentRequest, opts ...grpc.CallOption) (*RefundablePayment, error)
+	// RecordRefund updates the booking or ticket with a refund's outcome. It
+	// must be idempotent on refund_id, since recording is retried.
+	RecordRefund(ctx context.Context, in *RefundRecord, opts ...grpc.CallOption) (*RecordRefundResponse, error)
+	// GetDisputableBooking returns a client's booking with its vendor and
+	// status. It returns NOT_FOUND when the booking is not the client's.
+	GetDisputableBooking(ctx context.Context, in *GetDisputableBookingRequest, opts ...grpc.CallOption) (*DisputableBooking, error)
+	// RecordDispute keeps the service's copy of a dispute current. It is sent
+	// on every transition and must accept the same state more than once.
+	RecordDispute(ctx context.Context, in *DisputeRecord, opts ...grpc.CallOption) (*RecordDisputeResponse, error)
+	// RequestBookingFundRelease asks for a completed booking's held funds to
+	// be released to the vendor. It must be idempotent on booking_id.
+	RequestBookingFundRelease(ctx context.Context, in *BookingFundReleaseRequest, opts ...grpc.CallOption) (*BookingFundReleaseResponse, error)
+}
+
+type clientGatewayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientGatewayServiceClient(cc grpc.ClientConnInterface) ClientGatewayServiceClient {
+	return &clientGatewayServiceClient{cc}
+}
+
+func (c *clientGatewayServiceClient) AnonymizeUser(ctx context.Context, in *AnonymizeUserRequest, opts ...grpc.CallOption) (*AnonymizeUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_AnonymizeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) HandleRazorpayEvent(ctx context.Context, in *PaymentEventRequest, opts ...grpc.CallOption) (*PaymentEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentEventResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_HandleRazorpayEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetRefundablePayment(ctx context.Context, in *GetRefundablePaymentRequest, opts ...grpc.CallOption) (*RefundablePayment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundablePayment)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetRefundablePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) RecordRefund(ctx context.Context, in *RefundRecord, opts ...grpc.CallOption) (*RecordRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordRefundResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_RecordRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) GetDisputableBooking(ctx context.Context, in *GetDisputableBookingRequest, opts ...grpc.CallOption) (*DisputableBooking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputableBooking)
+	err := c.cc.Invoke(ctx, ClientGatewayService_GetDisputableBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) RecordDispute(ctx context.Context, in *DisputeRecord, opts ...grpc.CallOption) (*RecordDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordDisputeResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_RecordDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientGatewayServiceClient) RequestBookingFundRelease(ctx context.Context, in *BookingFundReleaseRequest, opts ...grpc.CallOption) (*BookingFundReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingFundReleaseResponse)
+	err := c.cc.Invoke(ctx, ClientGatewayService_RequestBookingFundRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientGatewayServiceServer is the server API for ClientGatewayService service.
+// All implementations must embed UnimplementedClientGatewayServiceServer
+// for forward compatibility.
+//
+// ClientGatewayService is implemented by the client service alongside
+// client.ClientService.
+type ClientGatewayServiceServer interface {
+	// AnonymizeUser removes a deleted client's personal data. It must be
+	// idempotent, since a failed deletion is retried from the start.
+	AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error)
+	// HandleRazorpayEvent applies a verified Razorpay webhook, or the
+	// payment.verified event the gateway sends after a checkout callback. It
+	// must be idempotent on event_id, since webhooks are retried.
+	HandleRazorpayEvent(context.Context, *PaymentEventRequest) (*PaymentEventResponse, error)
+	// GetRefundablePayment returns the charge behind a client's booking or
+	// ticket. It returns NOT_FOUND when the item is not the client's or was
+	// never paid for.
+	GetRefundablePayment(context.Context, *GetRefundablePaymentRequest) (*RefundablePayment, error)
+	// RecordRefund updates the booking or ticket with a refund's outcome. It
+	// must be idempotent on refund_id, since recording is retried.
+	RecordRefund(context.Context, *RefundRecord) (*RecordRefundResponse, error)
+	// GetDisputableBooking returns a client's booking with its vendor and
+	// status. It returns NOT_FOUND when the booking is not the client's.
+	GetDisputableBooking(context.Context, *GetDisputableBookingRequest) (*DisputableBooking, error)
+	// RecordDispute keeps the service's copy of a dispute current. It is sent
+	// on every transition and must accept the same state more than once.
+	RecordDispute(context.Context, *DisputeRecord) (*RecordDisputeResponse, error)
+	// RequestBookingFundRelease asks for a completed booking's held funds to
+	// be released to the vendor. It must be idempotent on booking_id.
+	RequestBookingFundRelease(context.Context, *BookingFundReleaseRequest) (*BookingFundReleaseResponse, error)
+	mustEmbedUnimplementedClientGatewayServiceServer()
+}
+
+// UnimplementedClientGatewayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClientGatewayServiceServer struct{}
+
+func (UnimplementedClientGatewayServiceServer) AnonymizeUser(context.Context, *AnonymizeUserRequest) (*AnonymizeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUser not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) HandleRazorpayEvent(context.Context, *PaymentEventRequest) (*PaymentEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleRazorpayEvent not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetRefundablePayment(context.Context, *GetRefundablePaymentRequest) (*RefundablePayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefundablePayment not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) RecordRefund(context.Context, *RefundRecord) (*RecordRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRefund not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) GetDisputableBooking(context.Context, *GetDisputableBookingRequest) (*DisputableBooking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDisputableBooking not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) RecordDispute(context.Context, *DisputeRecord) (*RecordDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDispute not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) RequestBookingFundRelease(context.Context, *BookingFundReleaseRequest) (*BookingFundReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBookingFundRelease not implemented")
+}
+func (UnimplementedClientGatewayServiceServer) mustEmbedUnimplementedClientGatewayServiceServer() {}
+func (UnimplementedClientGatewayServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeClientGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientGatewayServiceServer will
+// result in compilation errors.
+type UnsafeClientGatewayServiceServer interface {
+	mustEmbedUnimplementedClientGatewayServiceServer()
+}
+
+func RegisterClientGatewayServiceServer(s grpc.ServiceRegistrar, srv ClientGatewayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedClientGatewayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClientGatewayService_ServiceDesc, srv)
+}
+
+func _ClientGatewayService_AnonymizeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).AnonymizeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_AnonymizeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).AnonymizeUser(ctx, req.(*AnonymizeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_HandleRazorpayEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).HandleRazorpayEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_HandleRazorpayEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).HandleRazorpayEvent(ctx, req.(*PaymentEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_GetRefundablePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundablePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).GetRefundablePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_GetRefundablePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).GetRefundablePayment(ctx, req.(*GetRefundablePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_RecordRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).RecordRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_RecordRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).RecordRefund(ctx, req.(*RefundRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_GetDisputableBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisputableBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).GetDisputableBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_GetDisputableBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).GetDisputableBooking(ctx, req.(*GetDisputableBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_RecordDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisputeRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).RecordDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_RecordDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).RecordDispute(ctx, req.(*DisputeRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientGatewayService_RequestBookingFundRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingFundReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientGatewayServiceServer).RequestBookingFundRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientGatewayService_RequestBookingFundRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientGatewayServiceServer).RequestBookingFundRelease(ctx, req.(*BookingFundReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientGatewayService_ServiceDesc is the grpc.ServiceDesc for ClientGatewayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientGatewayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gateway.client.ClientGatewayService",
+	HandlerType: (*ClientGatewayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AnonymizeUser",
+			Handler:    _ClientGatewayService_AnonymizeUser_Handler,
+		},
+		{
+			MethodName: "HandleRazorpayEvent",
+			Handler:    _ClientGatewayService_HandleRazorpayEvent_Handler,
+		},
+		{
+			MethodName: "GetRefundablePayment",
+			Handler:    _ClientGatewayService_GetRefundablePayment_Handler,
+		},
+		{
+			MethodName: "RecordRefund",
+			Handler:    _ClientGatewayService_RecordRefund_Handler,
+		},
+		{
+			MethodName: "GetDisputableBooking",
+			Handler:    _ClientGatewayService_GetDisputableBooking_Handler,
+		},
+		{
+			MethodName: "RecordDispute",
+			Handler:    _ClientGatewayService_RecordDispute_Handler,
+		},
+		{
+			MethodName: "RequestBookingFundRelease",
+			Handler:    _ClientGatewayService_RequestBookingFundRelease_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "client/gateway_client.proto",
+}
@@ -0,0 +1,6 @@
+// Package gatewaypb holds the gRPC contracts the gateway needs from the
+// backend services on top of the ones published in proto-repo. Each service
+// implements its contract next to its existing proto-repo service.
+package gatewaypb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative auth/gateway_auth.proto client/gateway_client.proto vendor/gateway_vendor.proto admin/gateway_admin.proto
@@ -0,0 +1,242 @@
+package grpctls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	ModePlaintext = "plaintext"
+	ModeTLS       = "tls"
+	ModeMTLS      = "mtls"
+)
+
+var (
+	ErrPlaintextNotAllowed = errors.New("plaintext gRPC is only allowed when APP_ENV=development")
+	// ErrModeNotSet is returned for deployments that predate transport
+	// security, which used plaintext without any setting.
+	ErrModeNotSet = errors.New("gRPC transport mode is not set: set GRPC_TLS_MODE (or <SERVICE>_SVC_TLS_MODE) to tls, mtls, or plaintext with APP_ENV=development")
+)
+
+type Options struct {
+	Service        string
+	Mode           string
+	CAFile         string
+	CertFile       string
+	KeyFile        string
+	ServerName     string
+	ReloadInterval time.Duration
+}
+
+// FromConfig resolves the transport settings for one backend. Per-service
+// values win over GRPC_TLS_MODE. Mode is left empty when neither is set.
+func FromConfig(cfg *config.Config, service string) Options {
+	opts := Options{
+		Service:        service,
+		Mode:           cfg.GRPC_TLS_MODE,
+		ReloadInterval: config.GetDuration(cfg.GRPC_TLS_RELOAD_INTERVAL, time.Minute),
+	}
+
+	var mode string
+	switch service {
+	case "auth":
+		mode, opts.CAFile, opts.CertFile, opts.KeyFile, opts.ServerName = cfg.AUTH_SVC_TLS_MODE, cfg.AUTH_SVC_TLS_CA, cfg.AUTH_SVC_TLS_CERT, cfg.AUTH_SVC_TLS_KEY, cfg.AUTH_SVC_TLS_SERVER_NAME
+	case "client":
+		mode, opts.CAFile, opts.CertFile, opts.KeyFile, opts.ServerName = cfg.CLIENT_SVC_TLS_MODE, cfg.CLIENT_SVC_TLS_CA, cfg.CLIENT_SVC_TLS_CERT, cfg.CLIENT_SVC_TLS_KEY, cfg.CLIENT_SVC_TLS_SERVER_NAME
+	case "admin":
+		mode, opts.CAFile, opts.CertFile, opts.KeyFile, opts.ServerName = cfg.ADMIN_SVC_TLS_MODE, cfg.ADMIN_SVC_TLS_CA, cfg.ADMIN_SVC_TLS_CERT, cfg.ADMIN_SVC_TLS_KEY, cfg.ADMIN_SVC_TLS_SERVER_NAME
+	case "vendor":
+		mode, opts.CAFile, opts.CertFile, opts.KeyFile, opts.ServerName = cfg.VENDOR_SVC_TLS_MODE, cfg.VENDOR_SVC_TLS_CA, cfg.VENDOR_SVC_TLS_CERT, cfg.VENDOR_SVC_TLS_KEY, cfg.VENDOR_SVC_TLS_SERVER_NAME
+	}
+
+	if mode != "" {
+		opts.Mode = mode
+	}
+	opts.Mode = strings.ToLower(strings.TrimSpace(opts.Mode))
+
+	return opts
+}
+
+func Credentials(opts Options, development bool) (credentials.TransportCredentials, error) {
+	switch opts.Mode {
+	case "":
+		return nil, fmt.Errorf("%s: %w", opts.Service, ErrModeNotSet)
+	case ModePlaintext:
+		if !development {
+			return nil, ErrPlaintextNotAllowed
+		}
+		log.Printf("WARNING: %s gRPC connection is using plaintext", opts.Service)
+		return insecure.NewCredentials(), nil
+	case ModeTLS, ModeMTLS:
+	default:
+		return nil, fmt.Errorf("unknown gRPC TLS mode %q", opts.Mode)
+	}
+
+	if opts.Mode == ModeMTLS && (opts.CertFile == "" || opts.KeyFile == "") {
+		return nil, fmt.Errorf("%s: mtls requires a client certificate and key", opts.Service)
+	}
+	if (opts.CertFile == "") != (opts.KeyFile == "") {
+		return nil, fmt.Errorf("%s: client certificate and key must be set together", opts.Service)
+	}
+
+	r := &reloader{opts: opts}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opts.ServerName,
+		// The chain is verified in VerifyConnection against the current,
+		// possibly reloaded, CA pool.
+		InsecureSkipVerify: true,
+		VerifyConnection:   r.verify,
+	}
+	if opts.CertFile != "" {
+		tlsCfg.GetClientCertificate = r.clientCertificate
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// reloader keeps the CA pool and client certificate in sync with the files
+// on disk, re-reading them when their modification time changes.
+type reloader struct {
+	opts Options
+
+	mu        sync.RWMutex
+	roots     *x509.CertPool
+	cert      *tls.Certificate
+	modTimes  map[string]time.Time
+	checkedAt time.Time
+}
+
+func (r *reloader) files() []string {
+	var files []string
+	for _, f := range []string{r.opts.CAFile, r.opts.CertFile, r.opts.KeyFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (r *reloader) load() error {
+	modTimes := map[string]time.Time{}
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.opts.Service, err)
+		}
+		modTimes[f] = info.ModTime()
+	}
+
+	roots, err := x509.SystemCertPool()
+	if err != nil || r.opts.CAFile != "" {
+		roots = x509.NewCertPool()
+	}
+	if r.opts.CAFile != "" {
+		pem, err := os.ReadFile(r.opts.CAFile)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.opts.Service, err)
+		}
+		if !roots.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("%s: no certificates found in %s", r.opts.Service, r.opts.CAFile)
+		}
+	}
+
+	var cert *tls.Certificate
+	if r.opts.CertFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.opts.CertFile, r.opts.KeyFile)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.opts.Service, err)
+		}
+		cert = &pair
+	}
+
+	r.mu.Lock()
+	r.roots, r.cert, r.modTimes, r.checkedAt = roots, cert, modTimes, time.Now()
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *reloader) refresh() {
+	r.mu.RLock()
+	due := time.Since(r.checkedAt) >= r.opts.ReloadInterval
+	modTimes := r.modTimes
+	r.mu.RUnlock()
+
+	if !due || r.opts.ReloadInterval <= 0 {
+		return
+	}
+
+	changed := false
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err == nil && !info.ModTime().Equal(modTimes[f]) {
+			changed = true
+			break
+		}
+	}
+
+	if !changed {
+		r.mu.Lock()
+		r.checkedAt = time.Now()
+		r.mu.Unlock()
+		return
+	}
+
+	if err := r.load(); err != nil {
+		log.Printf("Failed to reload TLS material, keeping the previous one: %v", err)
+		r.mu.Lock()
+		r.checkedAt = time.Now()
+		r.mu.Unlock()
+		return
+	}
+
+	log.Printf("Reloaded TLS material for %s gRPC connection", r.opts.Service)
+}
+
+func (r *reloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.refresh()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+func (r *reloader) verify(cs tls.ConnectionState) error {
+	r.refresh()
+
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+
+	r.mu.RLock()
+	roots := r.roots
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
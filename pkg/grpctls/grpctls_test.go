@@ -0,0 +1,96 @@
+package grpctls
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+)
+
+func TestFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.Config
+		service string
+		want    Options
+	}{
+		{
+			name:    "unset",
+			service: "auth",
+			want:    Options{Service: "auth", ReloadInterval: time.Minute},
+		},
+		{
+			name:    "global mode",
+			cfg:     config.Config{GRPC_TLS_MODE: "tls"},
+			service: "client",
+			want:    Options{Service: "client", Mode: ModeTLS, ReloadInterval: time.Minute},
+		},
+		{
+			name:    "mode is normalised",
+			cfg:     config.Config{GRPC_TLS_MODE: " MTLS "},
+			service: "vendor",
+			want:    Options{Service: "vendor", Mode: ModeMTLS, ReloadInterval: time.Minute},
+		},
+		{
+			name: "service mode wins",
+			cfg: config.Config{
+				GRPC_TLS_MODE:             "tls",
+				ADMIN_SVC_TLS_MODE:        "mtls",
+				ADMIN_SVC_TLS_CA:          "ca.pem",
+				ADMIN_SVC_TLS_CERT:        "cert.pem",
+				ADMIN_SVC_TLS_KEY:         "key.pem",
+				ADMIN_SVC_TLS_SERVER_NAME: "admin.internal",
+				GRPC_TLS_RELOAD_INTERVAL:  "30s",
+			},
+			service: "admin",
+			want: Options{
+				Service:        "admin",
+				Mode:           ModeMTLS,
+				CAFile:         "ca.pem",
+				CertFile:       "cert.pem",
+				KeyFile:        "key.pem",
+				ServerName:     "admin.internal",
+				ReloadInterval: 30 * time.Second,
+			},
+		},
+		{
+			name:    "other service settings are ignored",
+			cfg:     config.Config{GRPC_TLS_MODE: "tls", AUTH_SVC_TLS_MODE: "plaintext", AUTH_SVC_TLS_CA: "ca.pem"},
+			service: "vendor",
+			want:    Options{Service: "vendor", Mode: ModeTLS, ReloadInterval: time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromConfig(&tt.cfg, tt.service)
+			if got != tt.want {
+				t.Errorf("FromConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCredentialsMode(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        string
+		development bool
+		wantErr     error
+	}{
+		{name: "unset", mode: "", wantErr: ErrModeNotSet},
+		{name: "unset in development", mode: "", development: true, wantErr: ErrModeNotSet},
+		{name: "plaintext outside development", mode: ModePlaintext, wantErr: ErrPlaintextNotAllowed},
+		{name: "plaintext in development", mode: ModePlaintext, development: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Credentials(Options{Service: "auth", Mode: tt.mode}, tt.development)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Credentials() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
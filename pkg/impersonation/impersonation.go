@@ -0,0 +1,132 @@
+package impersonation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// auditKey is a stream rather than a capped list so the trail is never
+// trimmed.
+const auditKey = "impersonation:audit:log"
+
+var ErrNotFound = errors.New("impersonation session not found")
+
+type Session struct {
+	ID        string `json:"id"`
+	AdminID   string `json:"admin_id"`
+	UserID    string `json:"user_id"`
+	Role      string `json:"role"`
+	Reason    string `json:"reason"`
+	StartedAt string `json:"started_at"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type AuditEntry struct {
+	ID        string `json:"id"`
+	SessionID string `json:"session_id"`
+	AdminID   string `json:"admin_id"`
+	UserID    string `json:"user_id"`
+	Role      string `json:"role"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status"`
+	IP        string `json:"ip"`
+	Blocked   bool   `json:"blocked,omitempty"`
+	Time      string `json:"time"`
+}
+
+func sessionKey(id string) string {
+	return "impersonation:session:" + id
+}
+
+func Start(ctx context.Context, rdb *redis.Client, adminID, userID, role, reason string, ttl time.Duration) (*Session, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	session := &Session{
+		ID:        hex.EncodeToString(buf),
+		AdminID:   adminID,
+		UserID:    userID,
+		Role:      role,
+		Reason:    reason,
+		StartedAt: now.Format(time.RFC3339),
+		ExpiresAt: now.Add(ttl).Format(time.RFC3339),
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rdb.Set(ctx, sessionKey(session.ID), data, ttl).Err(); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func Active(ctx context.Context, rdb *redis.Client, id string) (bool, error) {
+	count, err := rdb.Exists(ctx, sessionKey(id)).Result()
+	return count > 0, err
+}
+
+func End(ctx context.Context, rdb *redis.Client, id string) error {
+	deleted, err := rdb.Del(ctx, sessionKey(id)).Result()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func Record(ctx context.Context, rdb *redis.Client, entry AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: auditKey,
+		Values: map[string]any{"entry": data},
+	}).Err()
+}
+
+// Audit returns up to limit entries, newest first. before is the ID of the
+// last entry of the previous page, or empty for the first page.
+func Audit(ctx context.Context, rdb *redis.Client, before string, limit int64) ([]AuditEntry, error) {
+	end := "+"
+	if before != "" {
+		end = "(" + before
+	}
+
+	messages, err := rdb.XRevRangeN(ctx, auditKey, end, "-", limit).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AuditEntry, 0, len(messages))
+	for _, message := range messages {
+		value, _ := message.Values["entry"].(string)
+
+		var entry AuditEntry
+		if err := json.Unmarshal([]byte(value), &entry); err != nil {
+			continue
+		}
+		entry.ID = message.ID
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
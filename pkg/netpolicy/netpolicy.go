@@ -0,0 +1,191 @@
+package netpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+type Rule struct {
+	Prefix         string   `json:"prefix"`
+	Allow          []string `json:"allow"`
+	Deny           []string `json:"deny"`
+	AllowCountries []string `json:"allow_countries"`
+	DenyCountries  []string `json:"deny_countries"`
+}
+
+type rule struct {
+	prefix         string
+	allow          []*net.IPNet
+	deny           []*net.IPNet
+	allowCountries map[string]bool
+	denyCountries  map[string]bool
+}
+
+type Policy struct {
+	rules []rule
+	geo   *geoip2.Reader
+}
+
+// New parses the NETWORK_POLICIES rules. The GeoIP database is only opened
+// when geoDBPath is set and is required as soon as a rule uses countries.
+func New(rulesJSON, geoDBPath string) (*Policy, error) {
+	policy := &Policy{}
+
+	var rules []Rule
+	if strings.TrimSpace(rulesJSON) != "" {
+		if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+			return nil, fmt.Errorf("invalid NETWORK_POLICIES: %w", err)
+		}
+	}
+
+	needsGeo := false
+	for _, r := range rules {
+		if !strings.HasPrefix(r.Prefix, "/") {
+			return nil, fmt.Errorf("network policy prefix %q must start with /", r.Prefix)
+		}
+
+		compiled := rule{
+			prefix:         strings.TrimSuffix(r.Prefix, "/"),
+			allowCountries: countrySet(r.AllowCountries),
+			denyCountries:  countrySet(r.DenyCountries),
+		}
+
+		var err error
+		if compiled.allow, err = parseCIDRs(r.Allow); err != nil {
+			return nil, fmt.Errorf("network policy for %s: %w", r.Prefix, err)
+		}
+		if compiled.deny, err = parseCIDRs(r.Deny); err != nil {
+			return nil, fmt.Errorf("network policy for %s: %w", r.Prefix, err)
+		}
+
+		if len(compiled.allowCountries) > 0 || len(compiled.denyCountries) > 0 {
+			needsGeo = true
+		}
+		policy.rules = append(policy.rules, compiled)
+	}
+
+	sort.Slice(policy.rules, func(i, j int) bool {
+		return len(policy.rules[i].prefix) > len(policy.rules[j].prefix)
+	})
+
+	if geoDBPath != "" {
+		reader, err := geoip2.Open(geoDBPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not open GeoIP database: %w", err)
+		}
+		policy.geo = reader
+	} else if needsGeo {
+		return nil, fmt.Errorf("country rules need GEOIP_DB_PATH to be set")
+	}
+
+	return policy, nil
+}
+
+// Check reports whether ip may reach path. Paths without a matching rule
+// are always allowed.
+func (p *Policy) Check(path string, ip net.IP) (bool, string) {
+	r, ok := p.match(path)
+	if !ok {
+		return true, ""
+	}
+
+	if ip == nil {
+		return false, "unknown client address"
+	}
+
+	if contains(r.deny, ip) {
+		return false, "address is denied"
+	}
+	if len(r.allow) > 0 && !contains(r.allow, ip) {
+		return false, "address is not allowed"
+	}
+
+	if len(r.allowCountries) == 0 && len(r.denyCountries) == 0 {
+		return true, ""
+	}
+
+	country := p.country(ip)
+	if r.denyCountries[country] {
+		return false, "country " + country + " is denied"
+	}
+	if len(r.allowCountries) > 0 && !r.allowCountries[country] {
+		return false, "country " + country + " is not allowed"
+	}
+
+	return true, ""
+}
+
+func (p *Policy) Close() error {
+	if p.geo == nil {
+		return nil
+	}
+
+	return p.geo.Close()
+}
+
+func (p *Policy) match(path string) (rule, bool) {
+	for _, r := range p.rules {
+		if path == r.prefix || strings.HasPrefix(path, r.prefix+"/") {
+			return r, true
+		}
+	}
+
+	return rule{}, false
+}
+
+func (p *Policy) country(ip net.IP) string {
+	record, err := p.geo.Country(ip)
+	if err != nil {
+		log.Printf("GeoIP lookup failed for %s: %v", ip, err)
+		return ""
+	}
+
+	return record.Country.IsoCode
+}
+
+func parseCIDRs(values []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return nets, nil
+}
+
+func countrySet(codes []string) map[string]bool {
+	set := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		set[strings.ToUpper(strings.TrimSpace(code))] = true
+	}
+
+	return set
+}
+
+func contains(nets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,164 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const stateTTL = 10 * time.Minute
+
+var ErrInvalidState = errors.New("invalid or expired oauth state")
+
+type Flow struct {
+	State       string `json:"-"`
+	Provider    string `json:"provider"`
+	Role        string `json:"role"`
+	Verifier    string `json:"verifier"`
+	Nonce       string `json:"nonce"`
+	RedirectURI string `json:"redirect_uri"`
+}
+
+func stateKey(state string) string {
+	return "oauth:state:" + state
+}
+
+func StartFlow(ctx context.Context, rdb *redis.Client, provider, role, redirectURI string) (*Flow, error) {
+	state, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := randomString(64)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	flow := &Flow{
+		State:       state,
+		Provider:    provider,
+		Role:        role,
+		Verifier:    verifier,
+		Nonce:       nonce,
+		RedirectURI: redirectURI,
+	}
+
+	data, err := json.Marshal(flow)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rdb.Set(ctx, stateKey(state), data, stateTTL).Err(); err != nil {
+		return nil, err
+	}
+
+	return flow, nil
+}
+
+func ConsumeFlow(ctx context.Context, rdb *redis.Client, provider, state string) (*Flow, error) {
+	data, err := rdb.GetDel(ctx, stateKey(state)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrInvalidState
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var flow Flow
+	if err := json.Unmarshal(data, &flow); err != nil {
+		return nil, err
+	}
+
+	if flow.Provider != provider {
+		return nil, ErrInvalidState
+	}
+
+	flow.State = state
+	return &flow, nil
+}
+
+func (f *Flow) CodeChallenge() string {
+	sum := sha256.Sum256([]byte(f.Verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func AuthorizationURL(baseURL string, flow *Flow, usePKCE bool) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("state", flow.State)
+	if usePKCE {
+		query.Set("code_challenge", flow.CodeChallenge())
+		query.Set("code_challenge_method", "S256")
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// AllowedRedirect accepts an http(s) redirect whose host matches an
+// allowlist entry and whose cleaned path is the entry's path or lies
+// below it, so /callback does not also allow /callbackevil or
+// /callback/../admin.
+func AllowedRedirect(redirectURI string, allowlist []string) bool {
+	target, err := url.Parse(redirectURI)
+	if err != nil || target.User != nil || target.Host == "" {
+		return false
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return false
+	}
+
+	targetPath := cleanPath(target.Path)
+	for _, entry := range allowlist {
+		allowed, err := url.Parse(entry)
+		if err != nil {
+			continue
+		}
+
+		if target.Scheme != allowed.Scheme || !strings.EqualFold(target.Host, allowed.Host) {
+			continue
+		}
+
+		allowedPath := cleanPath(allowed.Path)
+		if targetPath == allowedPath || strings.HasPrefix(targetPath, strings.TrimSuffix(allowedPath, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cleanPath(p string) string {
+	if p == "" {
+		return "/"
+	}
+
+	return path.Clean("/" + p)
+}
+
+func randomString(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
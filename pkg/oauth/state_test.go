@@ -0,0 +1,39 @@
+package oauth
+
+import "testing"
+
+func TestAllowedRedirect(t *testing.T) {
+	allowlist := []string{"https://app.zyra.com/callback", "http://localhost:3000/", "https://admin.zyra.com/auth/done/"}
+
+	tests := []struct {
+		name        string
+		redirectURI string
+		want        bool
+	}{
+		{name: "exact path", redirectURI: "https://app.zyra.com/callback", want: true},
+		{name: "below the path", redirectURI: "https://app.zyra.com/callback/google?next=1", want: true},
+		{name: "host is case insensitive", redirectURI: "https://APP.zyra.com/callback", want: true},
+		{name: "path sharing a prefix", redirectURI: "https://app.zyra.com/callbackevil", want: false},
+		{name: "dot segments leave the path", redirectURI: "https://app.zyra.com/callback/../admin", want: false},
+		{name: "encoded dot segments leave the path", redirectURI: "https://app.zyra.com/callback/%2e%2e/admin", want: false},
+		{name: "dot segments that stay below the path", redirectURI: "https://app.zyra.com/callback/a/../b", want: true},
+		{name: "other path", redirectURI: "https://app.zyra.com/", want: false},
+		{name: "scheme mismatch", redirectURI: "http://app.zyra.com/callback", want: false},
+		{name: "other host", redirectURI: "https://evil.com/callback", want: false},
+		{name: "userinfo", redirectURI: "https://app.zyra.com@evil.com/callback", want: false},
+		{name: "javascript scheme", redirectURI: "javascript://app.zyra.com/callback", want: false},
+		{name: "relative", redirectURI: "/callback", want: false},
+		{name: "root entry allows any path", redirectURI: "http://localhost:3000/dashboard", want: true},
+		{name: "root entry with empty path", redirectURI: "http://localhost:3000", want: true},
+		{name: "trailing slash entry allows its own path", redirectURI: "https://admin.zyra.com/auth/done", want: true},
+		{name: "trailing slash entry rejects siblings", redirectURI: "https://admin.zyra.com/auth/donexyz", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AllowedRedirect(tt.redirectURI, allowlist); got != tt.want {
+				t.Errorf("AllowedRedirect(%q) = %v, want %v", tt.redirectURI, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package oidc
+
+import (
+	"context"
+
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+)
+
+type IdentityExchanger interface {
+	Exchange(ctx context.Context, identity *Identity) (*gwauth.TokenResponse, error)
+}
+
+type GRPCExchanger struct {
+	Client gwauth.AuthGatewayServiceClient
+}
+
+func (e *GRPCExchanger) Exchange(ctx context.Context, identity *Identity) (*gwauth.TokenResponse, error) {
+	return e.Client.ExternalLogin(ctx, &gwauth.ExternalLoginRequest{
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		Name:          identity.Name,
+		Role:          identity.Role,
+	})
+}
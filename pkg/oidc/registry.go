@@ -0,0 +1,55 @@
+package oidc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+)
+
+type Registry struct {
+	providers map[string]*Provider
+}
+
+func NewRegistry(cfg *config.Config) (*Registry, error) {
+	registry := &Registry{providers: make(map[string]*Provider)}
+	if strings.TrimSpace(cfg.OIDC_PROVIDERS) == "" {
+		return registry, nil
+	}
+
+	var providers []ProviderConfig
+	if err := json.Unmarshal([]byte(cfg.OIDC_PROVIDERS), &providers); err != nil {
+		return nil, fmt.Errorf("invalid OIDC_PROVIDERS: %w", err)
+	}
+
+	for _, provider := range providers {
+		name := strings.ToLower(provider.Name)
+		if name == "" || provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return nil, fmt.Errorf("oidc provider %q is missing name, issuer, client_id or redirect_url", provider.Name)
+		}
+
+		if name == "google" {
+			return nil, fmt.Errorf("oidc provider name %q is reserved", name)
+		}
+
+		provider.Name = name
+		registry.providers[name] = NewProvider(provider)
+	}
+
+	return registry, nil
+}
+
+func (r *Registry) Get(name string) (*Provider, bool) {
+	provider, ok := r.providers[strings.ToLower(name)]
+	return provider, ok
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+
+	return names
+}
@@ -0,0 +1,60 @@
+package passkey
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+)
+
+const challengeTTL = 5 * time.Minute
+
+const (
+	CeremonyRegister = "register"
+	CeremonyLogin    = "login"
+)
+
+var ErrInvalidChallenge = errors.New("passkey challenge is invalid or has expired")
+
+type Challenge struct {
+	Session webauthn.SessionData `json:"session"`
+	UserID  string               `json:"user_id,omitempty"`
+	Role    string               `json:"role,omitempty"`
+}
+
+func challengeKey(ceremony, challenge string) string {
+	return "passkey:challenge:" + ceremony + ":" + challenge
+}
+
+func SaveChallenge(ctx context.Context, rdb *redis.Client, ceremony string, challenge *Challenge) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, challengeKey(ceremony, challenge.Session.Challenge), data, challengeTTL).Err()
+}
+
+func ConsumeChallenge(ctx context.Context, rdb *redis.Client, ceremony, challenge string) (*Challenge, error) {
+	if challenge == "" {
+		return nil, ErrInvalidChallenge
+	}
+
+	data, err := rdb.GetDel(ctx, challengeKey(ceremony, challenge)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrInvalidChallenge
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stored Challenge
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
@@ -0,0 +1,22 @@
+package passkey
+
+import (
+	"context"
+
+	gwauth "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/auth"
+)
+
+type TokenIssuer interface {
+	Issue(ctx context.Context, owner *Owner) (*gwauth.TokenResponse, error)
+}
+
+type GRPCIssuer struct {
+	Client gwauth.AuthGatewayServiceClient
+}
+
+func (i *GRPCIssuer) Issue(ctx context.Context, owner *Owner) (*gwauth.TokenResponse, error) {
+	return i.Client.PasskeyLogin(ctx, &gwauth.PasskeyLoginRequest{
+		UserId: owner.UserID,
+		Role:   owner.Role,
+	})
+}
@@ -0,0 +1,97 @@
+package passkey
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrCredentialNotFound = errors.New("passkey not found")
+
+type Owner struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type CredentialStore interface {
+	Credentials(ctx context.Context, userID string) ([]webauthn.Credential, error)
+	Owner(ctx context.Context, credentialID []byte) (*Owner, error)
+	Save(ctx context.Context, owner Owner, credential *webauthn.Credential) error
+}
+
+type RedisStore struct {
+	rdb *redis.Client
+}
+
+func NewRedisStore(rdb *redis.Client) *RedisStore {
+	return &RedisStore{rdb: rdb}
+}
+
+func credentialsKey(userID string) string {
+	return "passkey:credentials:" + userID
+}
+
+func ownerKey(credentialID []byte) string {
+	return "passkey:owner:" + base64.RawURLEncoding.EncodeToString(credentialID)
+}
+
+func (s *RedisStore) Credentials(ctx context.Context, userID string) ([]webauthn.Credential, error) {
+	values, err := s.rdb.HVals(ctx, credentialsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := make([]webauthn.Credential, 0, len(values))
+	for _, value := range values {
+		var credential webauthn.Credential
+		if err := json.Unmarshal([]byte(value), &credential); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+
+	return credentials, nil
+}
+
+func (s *RedisStore) Owner(ctx context.Context, credentialID []byte) (*Owner, error) {
+	data, err := s.rdb.Get(ctx, ownerKey(credentialID)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrCredentialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var owner Owner
+	if err := json.Unmarshal(data, &owner); err != nil {
+		return nil, err
+	}
+
+	return &owner, nil
+}
+
+func (s *RedisStore) Save(ctx context.Context, owner Owner, credential *webauthn.Credential) error {
+	credentialData, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+
+	ownerData, err := json.Marshal(owner)
+	if err != nil {
+		return err
+	}
+
+	field := base64.RawURLEncoding.EncodeToString(credential.ID)
+
+	_, err = s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, credentialsKey(owner.UserID), field, credentialData)
+		pipe.Set(ctx, ownerKey(credential.ID), ownerData, 0)
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,60 @@
+package passkey
+
+import (
+	"errors"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+var ErrNotConfigured = errors.New("passkey login is not configured")
+
+type User struct {
+	ID          string
+	Role        string
+	Name        string
+	Credentials []webauthn.Credential
+}
+
+func (u *User) WebAuthnID() []byte {
+	return []byte(u.ID)
+}
+
+func (u *User) WebAuthnName() string {
+	return u.Name
+}
+
+func (u *User) WebAuthnDisplayName() string {
+	return u.Name
+}
+
+func (u *User) WebAuthnCredentials() []webauthn.Credential {
+	return u.Credentials
+}
+
+func New(cfg *config.Config) (*webauthn.WebAuthn, error) {
+	if cfg.PASSKEY_RP_ID == "" {
+		return nil, nil
+	}
+
+	name := cfg.PASSKEY_RP_NAME
+	if name == "" {
+		name = "Zyra Moments"
+	}
+
+	origins := config.GetList(cfg.PASSKEY_ORIGINS)
+	if len(origins) == 0 {
+		origins = []string{"https://" + cfg.PASSKEY_RP_ID}
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          cfg.PASSKEY_RP_ID,
+		RPDisplayName: name,
+		RPOrigins:     origins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationRequired,
+		},
+	})
+}
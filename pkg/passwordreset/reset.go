@@ -0,0 +1,117 @@
+package passwordreset
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	pendingTTL = 15 * time.Minute
+	TokenTTL   = 15 * time.Minute
+)
+
+var (
+	ErrNoPendingReset = errors.New("no password reset was requested for this email")
+	ErrInvalidToken   = errors.New("reset token is invalid or has expired")
+)
+
+type Request struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Role   string `json:"role"`
+}
+
+func pendingKey(email string) string {
+	return "pwreset:pending:" + strings.ToLower(email)
+}
+
+func tokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "pwreset:token:" + hex.EncodeToString(sum[:])
+}
+
+func MarkPending(ctx context.Context, rdb *redis.Client, req Request) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, pendingKey(req.Email), data, pendingTTL).Err()
+}
+
+func HasPending(ctx context.Context, rdb *redis.Client, email string) error {
+	count, err := rdb.Exists(ctx, pendingKey(email)).Result()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNoPendingReset
+	}
+
+	return nil
+}
+
+func IssueToken(ctx context.Context, rdb *redis.Client, email string) (string, error) {
+	data, err := rdb.GetDel(ctx, pendingKey(email)).Bytes()
+	if err == redis.Nil {
+		return "", ErrNoPendingReset
+	}
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	if err := rdb.Set(ctx, tokenKey(token), data, TokenTTL).Err(); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func LookupToken(ctx context.Context, rdb *redis.Client, token string) (*Request, error) {
+	return readToken(ctx, rdb, token, false)
+}
+
+func ConsumeToken(ctx context.Context, rdb *redis.Client, token string) (*Request, error) {
+	return readToken(ctx, rdb, token, true)
+}
+
+func readToken(ctx context.Context, rdb *redis.Client, token string, consume bool) (*Request, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+
+	cmd := rdb.Get
+	if consume {
+		cmd = rdb.GetDel
+	}
+
+	data, err := cmd(ctx, tokenKey(token)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var req Request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const FakeSignatureHeader = "X-Fake-Signature"
+
+// Fake accepts webhooks carrying X-Fake-Signature: valid and refunds
+// everything immediately. It is only registered in development.
+type Fake struct{}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) VerifyWebhook(header http.Header, _ []byte) error {
+	if header.Get(FakeSignatureHeader) != "valid" {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (f *Fake) NormalizeEvent(header http.Header, body []byte) (*Event, error) {
+	if err := f.VerifyWebhook(header, body); err != nil {
+		return nil, err
+	}
+
+	var event struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil || event.Type == "" {
+		return nil, ErrInvalidEvent
+	}
+
+	return &Event{Provider: f.Name(), ID: event.ID, Type: event.Type, Payload: body}, nil
+}
+
+func (f *Fake) SessionParams(metadata map[string]string) (map[string]string, error) {
+	return copyMetadata(metadata), nil
+}
+
+func (f *Fake) Refund(_ context.Context, req RefundRequest) (*Refund, error) {
+	return &Refund{ID: "fake_rf_" + uuid.NewString(), Status: "processed", Amount: req.Amount}, nil
+}
@@ -0,0 +1,108 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+)
+
+var (
+	ErrUnknownProvider  = errors.New("unknown payment provider")
+	ErrInvalidSignature = errors.New("webhook signature verification failed")
+	ErrInvalidEvent     = errors.New("invalid webhook event")
+	// ErrRefundRejected means the provider answered and did not issue the
+	// refund. Any other refund error leaves the outcome unknown.
+	ErrRefundRejected = errors.New("refund rejected by the provider")
+)
+
+// Event is a provider webhook reduced to what the client service needs.
+// Payload is the raw body so nothing the provider sent is lost.
+type Event struct {
+	Provider string `json:"provider"`
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Payload  []byte `json:"payload"`
+}
+
+// IdempotencyKey makes retries of the same refund safe. Providers that
+// lack idempotency keys record it on the refund and look for it before
+// issuing another.
+type RefundRequest struct {
+	PaymentID      string
+	Amount         int64
+	Reason         string
+	IdempotencyKey string
+}
+
+type Refund struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Amount int64  `json:"amount"`
+}
+
+type Provider interface {
+	Name() string
+	VerifyWebhook(header http.Header, body []byte) error
+	NormalizeEvent(header http.Header, body []byte) (*Event, error)
+	// SessionParams returns the metadata sent with CreateBookingSession,
+	// filling in whatever the provider's checkout needs.
+	SessionParams(metadata map[string]string) (map[string]string, error)
+	Refund(ctx context.Context, req RefundRequest) (*Refund, error)
+}
+
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[string]Provider)}
+}
+
+// DefaultRegistry registers Stripe and Razorpay, plus the fake provider when
+// APP_ENV=development.
+func DefaultRegistry(cfg *config.Config) *Registry {
+	registry := NewRegistry()
+	registry.Register(NewStripe(cfg))
+	registry.Register(NewRazorpay(cfg))
+	if cfg.APP_ENV == "development" {
+		registry.Register(&Fake{})
+	}
+
+	return registry
+}
+
+func (r *Registry) Register(provider Provider) {
+	r.providers[strings.ToLower(provider.Name())] = provider
+}
+
+func (r *Registry) Get(name string) (Provider, error) {
+	provider, ok := r.providers[strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+
+	return provider, nil
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func copyMetadata(metadata map[string]string) map[string]string {
+	params := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		params[key] = value
+	}
+
+	return params
+}
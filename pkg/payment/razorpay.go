@@ -0,0 +1,186 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/razorpay"
+)
+
+const razorpayAPI = "https://api.razorpay.com/v1"
+
+type Razorpay struct {
+	keyID         string
+	keySecret     string
+	webhookSecret string
+	client        *http.Client
+}
+
+func NewRazorpay(cfg *config.Config) *Razorpay {
+	return &Razorpay{
+		keyID:         cfg.RAZORPAY_KEY_ID,
+		keySecret:     cfg.RAZORPAY_KEY_SECRET,
+		webhookSecret: cfg.RAZORPAY_WEBHOOK_SECRET,
+		client:        &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (r *Razorpay) Name() string {
+	return "razorpay"
+}
+
+func (r *Razorpay) VerifyWebhook(header http.Header, body []byte) error {
+	if err := razorpay.VerifyWebhook(body, header.Get(razorpay.SignatureHeader), r.webhookSecret); err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (r *Razorpay) NormalizeEvent(header http.Header, body []byte) (*Event, error) {
+	if err := r.VerifyWebhook(header, body); err != nil {
+		return nil, err
+	}
+
+	eventType, err := razorpay.EventType(body)
+	if err != nil {
+		return nil, ErrInvalidEvent
+	}
+
+	return &Event{Provider: r.Name(), ID: header.Get(razorpay.EventIDHeader), Type: eventType, Payload: body}, nil
+}
+
+// SessionParams adds the public key id, which the checkout widget needs
+// alongside the order created by the client service.
+func (r *Razorpay) SessionParams(metadata map[string]string) (map[string]string, error) {
+	if r.keyID == "" {
+		return nil, fmt.Errorf("razorpay is not configured")
+	}
+
+	params := copyMetadata(metadata)
+	params["razorpay_key_id"] = r.keyID
+	if params["currency"] == "" {
+		params["currency"] = "INR"
+	}
+
+	return params, nil
+}
+
+// Refund issues a refund unless one carrying the same idempotency key
+// already exists on the payment, since Razorpay itself would pay out again.
+func (r *Razorpay) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	if req.IdempotencyKey != "" {
+		existing, err := r.findRefund(ctx, req.PaymentID, req.IdempotencyKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up earlier razorpay refunds: %w", err)
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+
+	payload := map[string]any{}
+	if req.Amount > 0 {
+		payload["amount"] = req.Amount
+	}
+
+	// Razorpay has no idempotency keys, so the key goes on the refund where
+	// findRefund can match a retry against it.
+	notes := map[string]string{}
+	if req.Reason != "" {
+		notes["reason"] = req.Reason
+	}
+	if req.IdempotencyKey != "" {
+		payload["receipt"] = req.IdempotencyKey
+		notes["refund_id"] = req.IdempotencyKey
+	}
+	if len(notes) > 0 {
+		payload["notes"] = notes
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, razorpayAPI+"/payments/"+req.PaymentID+"/refund", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(r.keyID, r.keySecret)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+		Amount int64  `json:"amount"`
+		Error  struct {
+			Description string `json:"description"`
+		} `json:"error"`
+	}
+	decodeErr := json.NewDecoder(resp.Body).Decode(&res)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return nil, fmt.Errorf("%w: %s", ErrRefundRejected, res.Error.Description)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("invalid razorpay response: %w", decodeErr)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("razorpay refund failed: %s", res.Error.Description)
+	}
+
+	return &Refund{ID: res.ID, Status: res.Status, Amount: res.Amount}, nil
+}
+
+// findRefund returns the payment's refund whose notes carry key. Razorpay
+// lists at most 100 refunds per page, far more than a payment ever gets.
+func (r *Razorpay) findRefund(ctx context.Context, paymentID, key string) (*Refund, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, razorpayAPI+"/payments/"+paymentID+"/refunds?count=100", nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(r.keyID, r.keySecret)
+
+	resp, err := r.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Items []struct {
+			ID     string            `json:"id"`
+			Status string            `json:"status"`
+			Amount int64             `json:"amount"`
+			Notes  map[string]string `json:"notes"`
+		} `json:"items"`
+		Error struct {
+			Description string `json:"description"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("invalid razorpay response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("razorpay refund lookup failed: %s", res.Error.Description)
+	}
+
+	for _, item := range res.Items {
+		if item.Notes["refund_id"] == key {
+			return &Refund{ID: item.ID, Status: item.Status, Amount: item.Amount}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,314 @@
+package privacy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	StatusScheduled = "scheduled"
+
+	deletionQueueKey  = "privacy:deletions"
+	deletionFailedKey = "privacy:deletions:failed"
+	retryDelay        = 10 * time.Minute
+	maxDeletionTries  = 10
+)
+
+var (
+	ErrDeletionNotFound  = errors.New("no account deletion is scheduled")
+	ErrDeletionScheduled = errors.New("account deletion is already scheduled")
+)
+
+type DeletionRequest struct {
+	UserID      string `json:"user_id"`
+	Role        string `json:"role"`
+	RequestedAt string `json:"requested_at"`
+	ExecuteAt   string `json:"execute_at"`
+	Status      string `json:"status"`
+	Attempts    int    `json:"attempts"`
+	LastError   string `json:"last_error,omitempty"`
+}
+
+// Step anonymizes the user's data in one backend service. Steps must be
+// idempotent because a failed deletion is retried from the beginning.
+type Step struct {
+	Service string
+	Roles   []string
+	Run     func(ctx context.Context, req *DeletionRequest) error
+}
+
+func (s Step) appliesTo(role string) bool {
+	if len(s.Roles) == 0 {
+		return true
+	}
+
+	for _, r := range s.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deletionKey(userID string) string {
+	return "privacy:deletion:" + userID
+}
+
+// ScheduleDeletion queues the user's data for anonymization after grace.
+// A deletion that failed for good can be requested again.
+func ScheduleDeletion(ctx context.Context, rdb *redis.Client, userID, role string, grace time.Duration) (*DeletionRequest, error) {
+	now := time.Now().UTC()
+	req := &DeletionRequest{
+		UserID:      userID,
+		Role:        role,
+		RequestedAt: now.Format(time.RFC3339),
+		ExecuteAt:   now.Add(grace).Format(time.RFC3339),
+		Status:      StatusScheduled,
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := rdb.SetNX(ctx, deletionKey(userID), data, 0).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		existing, err := GetDeletion(ctx, rdb, userID)
+		if err != nil {
+			return nil, err
+		}
+		if existing.Status != StatusFailed {
+			return nil, ErrDeletionScheduled
+		}
+
+		if err := rdb.Set(ctx, deletionKey(userID), data, 0).Err(); err != nil {
+			return nil, err
+		}
+		rdb.ZRem(ctx, deletionFailedKey, userID)
+	}
+
+	if err := rdb.ZAdd(ctx, deletionQueueKey, redis.Z{Score: float64(now.Add(grace).Unix()), Member: userID}).Err(); err != nil {
+		rdb.Del(ctx, deletionKey(userID))
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func GetDeletion(ctx context.Context, rdb *redis.Client, userID string) (*DeletionRequest, error) {
+	data, err := rdb.Get(ctx, deletionKey(userID)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrDeletionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var req DeletionRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// CancelDeletion drops a deletion that is still waiting or that failed.
+func CancelDeletion(ctx context.Context, rdb *redis.Client, userID string) error {
+	removed, err := rdb.ZRem(ctx, deletionQueueKey, userID).Result()
+	if err != nil {
+		return err
+	}
+
+	if removed == 0 {
+		removed, err = rdb.ZRem(ctx, deletionFailedKey, userID).Result()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Once the worker has claimed the request it is in neither set and
+	// anonymization is already under way.
+	if removed == 0 {
+		return ErrDeletionNotFound
+	}
+
+	return rdb.Del(ctx, deletionKey(userID)).Err()
+}
+
+// RetryDeletion puts a failed deletion back in the queue to run now.
+func RetryDeletion(ctx context.Context, rdb *redis.Client, userID string) (*DeletionRequest, error) {
+	removed, err := rdb.ZRem(ctx, deletionFailedKey, userID).Result()
+	if err != nil {
+		return nil, err
+	}
+	if removed == 0 {
+		return nil, ErrDeletionNotFound
+	}
+
+	req, err := GetDeletion(ctx, rdb, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	req.Status = StatusScheduled
+	req.Attempts = 0
+	req.ExecuteAt = now.Format(time.RFC3339)
+
+	if err := saveDeletion(ctx, rdb, req); err != nil {
+		return nil, err
+	}
+	if err := rdb.ZAdd(ctx, deletionQueueKey, redis.Z{Score: float64(now.Unix()), Member: userID}).Err(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// FailedDeletions lists deletions that ran out of retries and need an admin.
+func FailedDeletions(ctx context.Context, rdb *redis.Client) ([]DeletionRequest, error) {
+	ids, err := rdb.ZRange(ctx, deletionFailedKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]DeletionRequest, 0, len(ids))
+	for _, id := range ids {
+		req, err := GetDeletion(ctx, rdb, id)
+		if errors.Is(err, ErrDeletionNotFound) {
+			rdb.ZRem(ctx, deletionFailedKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *req)
+	}
+
+	return requests, nil
+}
+
+func saveDeletion(ctx context.Context, rdb *redis.Client, req *DeletionRequest) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, deletionKey(req.UserID), data, 0).Err()
+}
+
+type DeletionWorker struct {
+	rdb      *redis.Client
+	steps    []Step
+	interval time.Duration
+	onDone   func(ctx context.Context, req *DeletionRequest)
+}
+
+func NewDeletionWorker(rdb *redis.Client, steps []Step, interval time.Duration, onDone func(ctx context.Context, req *DeletionRequest)) *DeletionWorker {
+	return &DeletionWorker{rdb: rdb, steps: steps, interval: interval, onDone: onDone}
+}
+
+func (w *DeletionWorker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.runDue(ctx)
+			}
+		}
+	}()
+}
+
+func (w *DeletionWorker) runDue(ctx context.Context) {
+	due, err := w.rdb.ZRangeByScore(ctx, deletionQueueKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(time.Now().Unix(), 10),
+	}).Result()
+	if err != nil {
+		log.Println("Account deletion: failed to read queue:", err)
+		return
+	}
+
+	for _, userID := range due {
+		// ZREM acts as the claim so only one gateway instance processes a user.
+		claimed, err := w.rdb.ZRem(ctx, deletionQueueKey, userID).Result()
+		if err != nil || claimed == 0 {
+			continue
+		}
+
+		w.process(ctx, userID)
+	}
+}
+
+func (w *DeletionWorker) process(ctx context.Context, userID string) {
+	req, err := GetDeletion(ctx, w.rdb, userID)
+	if err != nil {
+		log.Printf("Account deletion: failed to load request for %s: %v", userID, err)
+		return
+	}
+
+	req.Attempts++
+	if err := w.runSteps(ctx, req); err != nil {
+		req.LastError = err.Error()
+		log.Printf("Account deletion for %s failed (attempt %d): %v", userID, req.Attempts, err)
+
+		if req.Attempts >= maxDeletionTries {
+			log.Printf("Account deletion for %s failed after %d attempts, waiting for an admin", userID, req.Attempts)
+			req.Status = StatusFailed
+			w.save(ctx, req)
+			w.rdb.ZAdd(ctx, deletionFailedKey, redis.Z{Score: float64(time.Now().Unix()), Member: userID})
+			return
+		}
+
+		w.save(ctx, req)
+		w.rdb.ZAdd(ctx, deletionQueueKey, redis.Z{Score: float64(time.Now().Add(retryDelay).Unix()), Member: userID})
+		return
+	}
+
+	if w.onDone != nil {
+		w.onDone(ctx, req)
+	}
+
+	w.rdb.Del(ctx, deletionKey(userID))
+	log.Printf("Account deletion for %s completed", userID)
+}
+
+func (w *DeletionWorker) runSteps(ctx context.Context, req *DeletionRequest) error {
+	for _, step := range w.steps {
+		if !step.appliesTo(req.Role) {
+			continue
+		}
+
+		stepCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		err := step.Run(stepCtx, req)
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", step.Service, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *DeletionWorker) save(ctx context.Context, req *DeletionRequest) {
+	if err := saveDeletion(ctx, w.rdb, req); err != nil {
+		log.Printf("Account deletion: failed to save request for %s: %v", req.UserID, err)
+	}
+}
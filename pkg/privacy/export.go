@@ -0,0 +1,295 @@
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	StatusPending = "pending"
+	StatusReady   = "ready"
+	StatusFailed  = "failed"
+
+	exportTTL      = 24 * time.Hour
+	exportTimeout  = 2 * time.Minute
+	exportCooldown = 24 * time.Hour
+
+	// A pending job older than this lost its goroutine, usually to a restart.
+	exportStaleAfter = exportTimeout + time.Minute
+)
+
+var (
+	ErrExportNotFound = errors.New("export not found")
+	ErrExportLimited  = errors.New("a data export was already requested today")
+)
+
+type Section struct {
+	Service string
+	Name    string
+	Fetch   func(ctx context.Context) (any, error)
+}
+
+type ExportJob struct {
+	ID          string            `json:"export_id"`
+	UserID      string            `json:"user_id"`
+	Role        string            `json:"role"`
+	Status      string            `json:"status"`
+	CreatedAt   string            `json:"created_at"`
+	CompletedAt string            `json:"completed_at,omitempty"`
+	Errors      map[string]string `json:"errors,omitempty"`
+}
+
+func exportKey(id string) string {
+	return "privacy:export:" + id
+}
+
+func archiveKey(id string) string {
+	return "privacy:export:" + id + ":archive"
+}
+
+func userExportKey(userID string) string {
+	return "privacy:export:user:" + userID
+}
+
+func newID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// StartExport records a pending export job and collects the sections in the
+// background. The job can be polled with GetExport. A user gets one export a
+// day unless the last one failed; ErrExportLimited comes with that export.
+func StartExport(ctx context.Context, rdb *redis.Client, userID, role string, sections []Section) (*ExportJob, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := rdb.SetNX(ctx, userExportKey(userID), id, exportCooldown).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		previousID, err := rdb.Get(ctx, userExportKey(userID)).Result()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+
+		previous, err := loadExport(ctx, rdb, previousID)
+		if err == nil && previous.Status != StatusFailed {
+			return previous, ErrExportLimited
+		}
+		if err != nil && !errors.Is(err, ErrExportNotFound) {
+			return nil, err
+		}
+
+		if err := rdb.Set(ctx, userExportKey(userID), id, exportCooldown).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	job := &ExportJob{
+		ID:        id,
+		UserID:    userID,
+		Role:      role,
+		Status:    StatusPending,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if err := saveExport(ctx, rdb, job); err != nil {
+		return nil, err
+	}
+
+	running := *job
+	go runExport(rdb, &running, sections)
+
+	return job, nil
+}
+
+func GetExport(ctx context.Context, rdb *redis.Client, userID, id string) (*ExportJob, error) {
+	job, err := loadExport(ctx, rdb, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.UserID != userID {
+		return nil, ErrExportNotFound
+	}
+
+	return job, nil
+}
+
+func loadExport(ctx context.Context, rdb *redis.Client, id string) (*ExportJob, error) {
+	data, err := rdb.Get(ctx, exportKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrExportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var job ExportJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, err
+	}
+
+	if job.Status == StatusPending {
+		created, err := time.Parse(time.RFC3339, job.CreatedAt)
+		if err == nil && time.Since(created) > exportStaleAfter {
+			job.Status = StatusFailed
+			job.Errors = map[string]string{"export": "the export was interrupted, request a new one"}
+			job.CompletedAt = time.Now().UTC().Format(time.RFC3339)
+			if err := saveExport(ctx, rdb, &job); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &job, nil
+}
+
+func ExportArchive(ctx context.Context, rdb *redis.Client, id string) ([]byte, error) {
+	data, err := rdb.Get(ctx, archiveKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrExportNotFound
+	}
+
+	return data, err
+}
+
+// ExportData returns export.json from the stored archive, so only the
+// compressed copy is kept in Redis.
+func ExportData(ctx context.Context, rdb *redis.Client, id string) ([]byte, error) {
+	archive, err := ExportArchive(ctx, rdb, id)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := zr.Open("export.json")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
+func saveExport(ctx context.Context, rdb *redis.Client, job *ExportJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, exportKey(job.ID), data, exportTTL).Err()
+}
+
+func runExport(rdb *redis.Client, job *ExportJob, sections []Section) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	collected := make(map[string]map[string]any)
+	job.Errors = make(map[string]string)
+
+	for _, section := range sections {
+		value, err := section.Fetch(ctx)
+		if err != nil {
+			job.Errors[section.Service+"/"+section.Name] = err.Error()
+			continue
+		}
+
+		if collected[section.Service] == nil {
+			collected[section.Service] = make(map[string]any)
+		}
+		collected[section.Service][section.Name] = value
+	}
+
+	document := map[string]any{
+		"user_id":     job.UserID,
+		"role":        job.Role,
+		"exported_at": time.Now().UTC().Format(time.RFC3339),
+		"data":        collected,
+	}
+	if len(job.Errors) > 0 {
+		document["errors"] = job.Errors
+	}
+
+	archive, err := buildArchive(document, collected)
+	if err != nil {
+		job.Status = StatusFailed
+		job.Errors["archive"] = err.Error()
+	} else {
+		err = rdb.Set(ctx, archiveKey(job.ID), archive, exportTTL).Err()
+		if err != nil {
+			job.Status = StatusFailed
+			job.Errors["storage"] = err.Error()
+		} else {
+			job.Status = StatusReady
+		}
+	}
+
+	job.CompletedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := saveExport(ctx, rdb, job); err != nil {
+		log.Printf("Failed to save export job %s: %v", job.ID, err)
+	}
+}
+
+func buildArchive(document map[string]any, collected map[string]map[string]any) ([]byte, error) {
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	if err := writeZipFile(zw, "export.json", data); err != nil {
+		return nil, err
+	}
+
+	for service, sections := range collected {
+		for name, value := range sections {
+			sectionData, err := json.MarshalIndent(value, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			if err := writeZipFile(zw, service+"/"+name+".json", sectionData); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeZipFile(zw *zip.Writer, name string, data []byte) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,66 @@
+package razorpay
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	SignatureHeader = "X-Razorpay-Signature"
+	EventIDHeader   = "X-Razorpay-Event-Id"
+
+	// EventPaymentVerified is forwarded after a checkout callback passes
+	// signature verification. Razorpay itself never sends it.
+	EventPaymentVerified = "payment.verified"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid razorpay signature")
+	ErrInvalidPayload   = errors.New("invalid razorpay payload")
+)
+
+// VerifyWebhook checks the X-Razorpay-Signature header, an HMAC-SHA256 of
+// the raw body keyed with the webhook secret.
+func VerifyWebhook(body []byte, signature, secret string) error {
+	return verify(body, signature, secret)
+}
+
+// VerifyPayment checks the signature handed to the checkout callback, an
+// HMAC-SHA256 of "<order_id>|<payment_id>" keyed with the API key secret.
+func VerifyPayment(orderID, paymentID, signature, keySecret string) error {
+	return verify([]byte(orderID+"|"+paymentID), signature, keySecret)
+}
+
+func verify(message []byte, signature, secret string) error {
+	if secret == "" || signature == "" {
+		return ErrInvalidSignature
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// EventType returns the "event" field of a webhook body.
+func EventType(body []byte) (string, error) {
+	var event struct {
+		Event string `json:"event"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil || event.Event == "" {
+		return "", ErrInvalidPayload
+	}
+
+	return event.Event, nil
+}
@@ -0,0 +1,92 @@
+package razorpay
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func sign(message, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	body := `{"event":"payment.captured"}`
+
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+		secret    string
+		wantErr   error
+	}{
+		{name: "valid", body: body, signature: sign(body, "whsec"), secret: "whsec"},
+		{name: "wrong secret", body: body, signature: sign(body, "other"), secret: "whsec", wantErr: ErrInvalidSignature},
+		{name: "tampered body", body: `{"event":"payment.failed"}`, signature: sign(body, "whsec"), secret: "whsec", wantErr: ErrInvalidSignature},
+		{name: "not hex", body: body, signature: "zz", secret: "whsec", wantErr: ErrInvalidSignature},
+		{name: "missing signature", body: body, secret: "whsec", wantErr: ErrInvalidSignature},
+		{name: "missing secret", body: body, signature: sign(body, ""), wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyWebhook([]byte(tt.body), tt.signature, tt.secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyWebhook() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyPayment(t *testing.T) {
+	valid := sign("order_1|pay_1", "key")
+
+	tests := []struct {
+		name      string
+		orderID   string
+		paymentID string
+		signature string
+		wantErr   error
+	}{
+		{name: "valid", orderID: "order_1", paymentID: "pay_1", signature: valid},
+		{name: "other payment", orderID: "order_1", paymentID: "pay_2", signature: valid, wantErr: ErrInvalidSignature},
+		{name: "other order", orderID: "order_2", paymentID: "pay_1", signature: valid, wantErr: ErrInvalidSignature},
+		{name: "ids swapped", orderID: "pay_1", paymentID: "order_1", signature: valid, wantErr: ErrInvalidSignature},
+		{name: "missing signature", orderID: "order_1", paymentID: "pay_1", wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPayment(tt.orderID, tt.paymentID, tt.signature, "key")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyPayment() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEventType(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string
+		wantErr error
+	}{
+		{name: "event", body: `{"event":"refund.processed"}`, want: "refund.processed"},
+		{name: "missing event", body: `{"entity":"event"}`, wantErr: ErrInvalidPayload},
+		{name: "malformed", body: `{`, wantErr: ErrInvalidPayload},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EventType([]byte(tt.body))
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("EventType() = %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package refund
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gwadmin "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/admin"
+	gwclient "github.com/AthulKrishna2501/zyra-api-gateway/pkg/gatewaypb/client"
+)
+
+// Payment is what the client service knows about the charge behind a
+// booking or ticket.
+type Payment struct {
+	Provider  string
+	PaymentID string
+	Amount    int64
+	ServiceAt time.Time
+}
+
+type Backend interface {
+	Lookup(ctx context.Context, clientID, kind, referenceID string) (*Payment, error)
+	Record(ctx context.Context, r *Refund) error
+}
+
+type GRPCBackend struct {
+	Client gwclient.ClientGatewayServiceClient
+	Admin  gwadmin.AdminGatewayServiceClient
+}
+
+func (b *GRPCBackend) Lookup(ctx context.Context, clientID, kind, referenceID string) (*Payment, error) {
+	res, err := b.Client.GetRefundablePayment(ctx, &gwclient.GetRefundablePaymentRequest{
+		ClientId:    clientID,
+		Kind:        kind,
+		ReferenceId: referenceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Provider == "" || res.PaymentId == "" || res.ServiceAt == nil {
+		return nil, fmt.Errorf("incomplete payment details for %s %s", kind, referenceID)
+	}
+
+	return &Payment{Provider: res.Provider, PaymentID: res.PaymentId, Amount: res.Amount, ServiceAt: res.ServiceAt.AsTime()}, nil
+}
+
+// Record tells both services about the outcome so the client's booking and
+// the admin wallet stay in step with the provider.
+func (b *GRPCBackend) Record(ctx context.Context, r *Refund) error {
+	if _, err := b.Client.RecordRefund(ctx, &gwclient.RefundRecord{
+		RefundId:         r.ID,
+		ClientId:         r.ClientID,
+		Kind:             r.Kind,
+		ReferenceId:      r.ReferenceID,
+		Provider:         r.Provider,
+		PaymentId:        r.PaymentID,
+		ProviderRefundId: r.ProviderRefundID,
+		Amount:           r.Amount,
+		Status:           r.Status,
+	}); err != nil {
+		return fmt.Errorf("client: %w", err)
+	}
+
+	if _, err := b.Admin.RecordRefund(ctx, &gwadmin.RefundRecord{
+		RefundId:         r.ID,
+		ClientId:         r.ClientID,
+		Kind:             r.Kind,
+		ReferenceId:      r.ReferenceID,
+		Provider:         r.Provider,
+		PaymentId:        r.PaymentID,
+		ProviderRefundId: r.ProviderRefundID,
+		Amount:           r.Amount,
+		Status:           r.Status,
+	}); err != nil {
+		return fmt.Errorf("admin: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package refund
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Tier refunds Percent of the amount paid when cancelling at least
+// MinHoursBefore hours ahead of the service or event.
+type Tier struct {
+	MinHoursBefore int `json:"min_hours_before"`
+	Percent        int `json:"percent"`
+}
+
+type Policy struct {
+	tiers []Tier
+}
+
+var defaultTiers = []Tier{
+	{MinHoursBefore: 7 * 24, Percent: 100},
+	{MinHoursBefore: 48, Percent: 50},
+	{MinHoursBefore: 0, Percent: 0},
+}
+
+// ParsePolicy reads REFUND_POLICY, falling back to full refunds a week
+// ahead, half refunds two days ahead and nothing after that.
+func ParsePolicy(policyJSON string) (*Policy, error) {
+	tiers := defaultTiers
+	if strings.TrimSpace(policyJSON) != "" {
+		var configured []Tier
+		if err := json.Unmarshal([]byte(policyJSON), &configured); err != nil {
+			return nil, fmt.Errorf("invalid REFUND_POLICY: %w", err)
+		}
+		tiers = configured
+	}
+
+	for _, tier := range tiers {
+		if tier.Percent < 0 || tier.Percent > 100 || tier.MinHoursBefore < 0 {
+			return nil, fmt.Errorf("invalid refund tier %+v", tier)
+		}
+	}
+
+	sorted := append([]Tier(nil), tiers...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinHoursBefore > sorted[j].MinHoursBefore
+	})
+
+	return &Policy{tiers: sorted}, nil
+}
+
+// Quote returns the refundable amount and the percentage applied.
+func (p *Policy) Quote(amount int64, serviceAt, now time.Time) (int64, int) {
+	hoursBefore := serviceAt.Sub(now).Hours()
+	for _, tier := range p.tiers {
+		if hoursBefore >= float64(tier.MinHoursBefore) {
+			return amount * int64(tier.Percent) / 100, tier.Percent
+		}
+	}
+
+	return 0, 0
+}
+
+func (p *Policy) Tiers() []Tier {
+	return p.tiers
+}
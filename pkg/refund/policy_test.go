@@ -0,0 +1,77 @@
+package refund
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyQuote(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	defaults, err := ParsePolicy("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	custom, err := ParsePolicy(`[{"min_hours_before":0,"percent":10},{"min_hours_before":24,"percent":75}]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		policy      *Policy
+		amount      int64
+		serviceAt   time.Time
+		wantAmount  int64
+		wantPercent int
+	}{
+		{name: "more than a week ahead", policy: defaults, amount: 10000, serviceAt: now.Add(8 * 24 * time.Hour), wantAmount: 10000, wantPercent: 100},
+		{name: "exactly a week ahead", policy: defaults, amount: 10000, serviceAt: now.Add(7 * 24 * time.Hour), wantAmount: 10000, wantPercent: 100},
+		{name: "just under a week ahead", policy: defaults, amount: 10000, serviceAt: now.Add(7*24*time.Hour - time.Minute), wantAmount: 5000, wantPercent: 50},
+		{name: "exactly two days ahead", policy: defaults, amount: 10000, serviceAt: now.Add(48 * time.Hour), wantAmount: 5000, wantPercent: 50},
+		{name: "a day ahead", policy: defaults, amount: 10000, serviceAt: now.Add(24 * time.Hour), wantAmount: 0, wantPercent: 0},
+		{name: "after the service", policy: defaults, amount: 10000, serviceAt: now.Add(-time.Hour), wantAmount: 0, wantPercent: 0},
+		{name: "rounds down", policy: defaults, amount: 999, serviceAt: now.Add(72 * time.Hour), wantAmount: 499, wantPercent: 50},
+		{name: "unsorted tiers are sorted", policy: custom, amount: 2000, serviceAt: now.Add(30 * time.Hour), wantAmount: 1500, wantPercent: 75},
+		{name: "lowest custom tier", policy: custom, amount: 2000, serviceAt: now.Add(time.Hour), wantAmount: 200, wantPercent: 10},
+		{name: "no tier reaches past the service", policy: custom, amount: 2000, serviceAt: now.Add(-time.Hour), wantAmount: 0, wantPercent: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, percent := tt.policy.Quote(tt.amount, tt.serviceAt, now)
+			if amount != tt.wantAmount || percent != tt.wantPercent {
+				t.Errorf("Quote() = %d, %d%%, want %d, %d%%", amount, percent, tt.wantAmount, tt.wantPercent)
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{name: "default"},
+		{name: "custom", policy: `[{"min_hours_before":12,"percent":100}]`},
+		{name: "malformed", policy: `[`, wantErr: true},
+		{name: "percent above 100", policy: `[{"min_hours_before":0,"percent":101}]`, wantErr: true},
+		{name: "negative percent", policy: `[{"min_hours_before":0,"percent":-1}]`, wantErr: true},
+		{name: "negative hours", policy: `[{"min_hours_before":-1,"percent":50}]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePolicy(tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got := defaultTiers[0]; got != (Tier{MinHoursBefore: 7 * 24, Percent: 100}) {
+		t.Errorf("ParsePolicy changed the default tiers: %+v", got)
+	}
+}
@@ -0,0 +1,422 @@
+package refund
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	KindBooking = "booking"
+	KindTicket  = "ticket"
+
+	StatusPendingApproval = "pending_approval"
+	StatusProcessing      = "processing"
+	// StatusUnconfirmed means the provider call failed without saying
+	// whether the refund was issued.
+	StatusUnconfirmed = "unconfirmed"
+	StatusSucceeded   = "succeeded"
+	StatusFailed      = "failed"
+	StatusRejected    = "rejected"
+
+	allKey          = "refunds:all"
+	pendingKey      = "refunds:pending"
+	recordFailedKey = "refunds:record_failed"
+
+	recordAttempts = 3
+)
+
+var (
+	ErrNotFound       = errors.New("refund not found")
+	ErrAlreadyExists  = errors.New("a refund has already been requested for this item")
+	ErrNotRefundable  = errors.New("nothing is refundable under the cancellation policy")
+	ErrNotPending     = errors.New("refund is not awaiting approval")
+	ErrUnconfirmed    = errors.New("refund may already have been paid, approve it again to settle it with the provider")
+	ErrInvalidKind    = errors.New("kind must be booking or ticket")
+	ErrExceedsPayment = errors.New("refund amount exceeds the amount paid")
+)
+
+type Refund struct {
+	ID               string `json:"id"`
+	ClientID         string `json:"client_id"`
+	Kind             string `json:"kind"`
+	ReferenceID      string `json:"reference_id"`
+	Provider         string `json:"provider"`
+	PaymentID        string `json:"payment_id"`
+	AmountPaid       int64  `json:"amount_paid"`
+	Percent          int    `json:"percent"`
+	Amount           int64  `json:"amount"`
+	Reason           string `json:"reason,omitempty"`
+	Status           string `json:"status"`
+	ProviderRefundID string `json:"provider_refund_id,omitempty"`
+	Error            string `json:"error,omitempty"`
+	RecordError      string `json:"record_error,omitempty"`
+	ReviewedBy       string `json:"reviewed_by,omitempty"`
+	ReviewNote       string `json:"review_note,omitempty"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+}
+
+func refundKey(id string) string {
+	return "refund:" + id
+}
+
+func clientKey(clientID string) string {
+	return "refunds:client:" + clientID
+}
+
+func referenceKey(kind, referenceID string) string {
+	return "refund:ref:" + kind + ":" + referenceID
+}
+
+// Create quotes the refund and stores it. Only one refund may exist per
+// booking or ticket unless the previous one was rejected or failed.
+func Create(ctx context.Context, rdb *redis.Client, policy *Policy, clientID, kind, referenceID, reason string, paid *Payment) (*Refund, error) {
+	amount, percent := policy.Quote(paid.Amount, paid.ServiceAt, time.Now())
+	return create(ctx, rdb, clientID, kind, referenceID, reason, paid, amount, percent)
+}
+
+// CreateForAmount stores a refund for an amount decided outside the
+// cancellation policy, such as a dispute ruling.
+func CreateForAmount(ctx context.Context, rdb *redis.Client, clientID, kind, referenceID, reason string, paid *Payment, amount int64) (*Refund, error) {
+	if amount > paid.Amount {
+		return nil, ErrExceedsPayment
+	}
+
+	var percent int
+	if paid.Amount > 0 {
+		percent = int(amount * 100 / paid.Amount)
+	}
+
+	return create(ctx, rdb, clientID, kind, referenceID, reason, paid, amount, percent)
+}
+
+func create(ctx context.Context, rdb *redis.Client, clientID, kind, referenceID, reason string, paid *Payment, amount int64, percent int) (*Refund, error) {
+	if kind != KindBooking && kind != KindTicket {
+		return nil, ErrInvalidKind
+	}
+	if amount <= 0 {
+		return nil, ErrNotRefundable
+	}
+
+	now := time.Now().UTC()
+	r := &Refund{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		Kind:        kind,
+		ReferenceID: referenceID,
+		Provider:    paid.Provider,
+		PaymentID:   paid.PaymentID,
+		AmountPaid:  paid.Amount,
+		Percent:     percent,
+		Amount:      amount,
+		Reason:      reason,
+		Status:      StatusPendingApproval,
+		CreatedAt:   now.Format(time.RFC3339),
+		UpdatedAt:   now.Format(time.RFC3339),
+	}
+
+	claimed, err := rdb.SetNX(ctx, referenceKey(kind, referenceID), r.ID, 0).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, ErrAlreadyExists
+	}
+
+	if err := Save(ctx, rdb, r); err != nil {
+		rdb.Del(ctx, referenceKey(kind, referenceID))
+		return nil, err
+	}
+
+	score := float64(now.Unix())
+	pipe := rdb.TxPipeline()
+	pipe.ZAdd(ctx, clientKey(clientID), redis.Z{Score: score, Member: r.ID})
+	pipe.ZAdd(ctx, allKey, redis.Z{Score: score, Member: r.ID})
+	pipe.ZAdd(ctx, pendingKey, redis.Z{Score: score, Member: r.ID})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Existing returns the ID of the refund holding a booking or ticket, or an
+// empty string when a new refund can be created for it.
+func Existing(ctx context.Context, rdb *redis.Client, kind, referenceID string) (string, error) {
+	id, err := rdb.Get(ctx, referenceKey(kind, referenceID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+
+	return id, err
+}
+
+func Get(ctx context.Context, rdb *redis.Client, id string) (*Refund, error) {
+	data, err := rdb.Get(ctx, refundKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var r Refund
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func Save(ctx context.Context, rdb *redis.Client, r *Refund) error {
+	r.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, refundKey(r.ID), data, 0).Err()
+}
+
+func ListForClient(ctx context.Context, rdb *redis.Client, clientID string, limit int64) ([]Refund, error) {
+	return list(ctx, rdb, clientKey(clientID), limit)
+}
+
+// List returns every refund, or only those awaiting approval when
+// pendingOnly is set, newest first.
+func List(ctx context.Context, rdb *redis.Client, pendingOnly bool, limit int64) ([]Refund, error) {
+	if pendingOnly {
+		return list(ctx, rdb, pendingKey, limit)
+	}
+
+	return list(ctx, rdb, allKey, limit)
+}
+
+func list(ctx context.Context, rdb *redis.Client, key string, limit int64) ([]Refund, error) {
+	ids, err := rdb.ZRevRange(ctx, key, 0, limit-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := make([]Refund, 0, len(ids))
+	for _, id := range ids {
+		r, err := Get(ctx, rdb, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, *r)
+	}
+
+	return refunds, nil
+}
+
+// Claim moves a pending or unconfirmed refund out of the approval queue
+// so it can be processed. ZREM makes sure two admins approving at once
+// only process it once.
+func Claim(ctx context.Context, rdb *redis.Client, id string) (*Refund, error) {
+	r, err := Get(ctx, rdb, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return claim(ctx, rdb, r, StatusPendingApproval, StatusUnconfirmed)
+}
+
+// ClaimForRejection only takes refunds that never reached the provider.
+// An unconfirmed refund may already have been paid, so it can only be
+// approved again, which settles it with the provider.
+func ClaimForRejection(ctx context.Context, rdb *redis.Client, id string) (*Refund, error) {
+	r, err := Get(ctx, rdb, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == StatusUnconfirmed {
+		return nil, ErrUnconfirmed
+	}
+
+	return claim(ctx, rdb, r, StatusPendingApproval)
+}
+
+// claim checks the status before touching the queue so a refund that
+// cannot be claimed stays where it is.
+func claim(ctx context.Context, rdb *redis.Client, r *Refund, statuses ...string) (*Refund, error) {
+	if !slices.Contains(statuses, r.Status) {
+		return nil, ErrNotPending
+	}
+
+	removed, err := rdb.ZRem(ctx, pendingKey, r.ID).Result()
+	if err != nil {
+		return nil, err
+	}
+	if removed == 0 {
+		return nil, ErrNotPending
+	}
+
+	return r, nil
+}
+
+func Reject(ctx context.Context, rdb *redis.Client, r *Refund, adminID, note string) error {
+	r.Status = StatusRejected
+	r.ReviewedBy = adminID
+	r.ReviewNote = note
+	if err := Save(ctx, rdb, r); err != nil {
+		return err
+	}
+
+	return rdb.Del(ctx, referenceKey(r.Kind, r.ReferenceID)).Err()
+}
+
+// Fail marks a claimed refund failed before it reached the provider and
+// frees the item for a new request.
+func Fail(ctx context.Context, rdb *redis.Client, r *Refund, cause error) error {
+	r.Status = StatusFailed
+	r.Error = cause.Error()
+	if err := Save(ctx, rdb, r); err != nil {
+		return err
+	}
+
+	return rdb.Del(ctx, referenceKey(r.Kind, r.ReferenceID)).Err()
+}
+
+// Process issues the refund with the provider and records the outcome in
+// the backends. A rejected refund frees the item for a new request. When
+// the outcome is unknown the item stays locked and the refund goes back to
+// the approval queue. Approving it again passes r.ID as the idempotency
+// key: Stripe replays the earlier refund, and Razorpay finds a refund
+// already carrying that ID in its notes instead of issuing another.
+func Process(ctx context.Context, rdb *redis.Client, provider payment.Provider, backend Backend, r *Refund) error {
+	r.Status = StatusProcessing
+	if err := Save(ctx, rdb, r); err != nil {
+		return err
+	}
+
+	res, err := provider.Refund(ctx, payment.RefundRequest{
+		PaymentID:      r.PaymentID,
+		Amount:         r.Amount,
+		Reason:         r.Reason,
+		IdempotencyKey: r.ID,
+	})
+	switch {
+	case err == nil:
+		r.Status = StatusSucceeded
+		r.ProviderRefundID = res.ID
+		r.Error = ""
+	case errors.Is(err, payment.ErrRefundRejected):
+		r.Status = StatusFailed
+		r.Error = err.Error()
+		rdb.Del(ctx, referenceKey(r.Kind, r.ReferenceID))
+	default:
+		r.Status = StatusUnconfirmed
+		r.Error = err.Error()
+		if zErr := rdb.ZAdd(ctx, pendingKey, redis.Z{Score: float64(time.Now().Unix()), Member: r.ID}).Err(); zErr != nil {
+			log.Printf("Refund %s: failed to requeue unconfirmed refund: %v", r.ID, zErr)
+		}
+	}
+
+	if r.Status != StatusUnconfirmed {
+		RecordOutcome(ctx, rdb, backend, r)
+	}
+
+	if saveErr := Save(ctx, rdb, r); saveErr != nil {
+		log.Printf("Refund %s: failed to save outcome: %v", r.ID, saveErr)
+	}
+
+	return err
+}
+
+// RecordOutcome sends the refund to the backends, retrying a few times.
+// A refund that still could not be recorded is left to the RecordWorker.
+// The caller saves r.
+func RecordOutcome(ctx context.Context, rdb *redis.Client, backend Backend, r *Refund) {
+	var err error
+	for attempt := 1; attempt <= recordAttempts; attempt++ {
+		if err = backend.Record(ctx, r); err == nil {
+			break
+		}
+		if attempt < recordAttempts {
+			time.Sleep(time.Duration(attempt) * 500 * time.Millisecond)
+		}
+	}
+
+	if err == nil {
+		r.RecordError = ""
+		rdb.ZRem(ctx, recordFailedKey, r.ID)
+		return
+	}
+
+	log.Printf("Refund %s: failed to record outcome: %v", r.ID, err)
+	r.RecordError = err.Error()
+	if zErr := rdb.ZAdd(ctx, recordFailedKey, redis.Z{Score: float64(time.Now().Unix()), Member: r.ID}).Err(); zErr != nil {
+		log.Printf("Refund %s: failed to queue record retry: %v", r.ID, zErr)
+	}
+}
+
+// RecordWorker keeps retrying refunds whose outcome the backends have not
+// acknowledged.
+type RecordWorker struct {
+	rdb      *redis.Client
+	backend  Backend
+	interval time.Duration
+}
+
+func NewRecordWorker(rdb *redis.Client, backend Backend, interval time.Duration) *RecordWorker {
+	return &RecordWorker{rdb: rdb, backend: backend, interval: interval}
+}
+
+func (w *RecordWorker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.run(ctx)
+			}
+		}
+	}()
+}
+
+func (w *RecordWorker) run(ctx context.Context) {
+	ids, err := w.rdb.ZRange(ctx, recordFailedKey, 0, 99).Result()
+	if err != nil {
+		log.Printf("Refunds: failed to read record retries: %v", err)
+		return
+	}
+
+	for _, id := range ids {
+		// ZREM acts as the claim so only one gateway instance retries a refund.
+		claimed, err := w.rdb.ZRem(ctx, recordFailedKey, id).Result()
+		if err != nil || claimed == 0 {
+			continue
+		}
+
+		r, err := Get(ctx, w.rdb, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			w.rdb.ZAdd(ctx, recordFailedKey, redis.Z{Score: float64(time.Now().Unix()), Member: id})
+			continue
+		}
+
+		RecordOutcome(ctx, w.rdb, w.backend, r)
+		if err := Save(ctx, w.rdb, r); err != nil {
+			log.Printf("Refund %s: failed to save outcome: %v", r.ID, err)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	RevokedPrefix = "session:revoked:"
+	defaultTTL    = 24 * time.Hour
+	touchInterval = time.Minute
+
+	// refreshTTL outlives any refresh token the auth service issues, so a
+	// revoked session cannot be brought back by refreshing.
+	refreshTTL = 30 * 24 * time.Hour
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+var (
+	touchMu     sync.Mutex
+	lastTouched = make(map[string]time.Time)
+
+	familyMu sync.RWMutex
+	families = make(map[string]string)
+)
+
+type Session struct {
+	ID        string `json:"session_id"`
+	UserID    string `json:"user_id"`
+	Role      string `json:"role"`
+	Device    string `json:"device"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+	CreatedAt string `json:"created_at"`
+	LastSeen  string `json:"last_seen"`
+	Current   bool   `json:"current"`
+}
+
+func ID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+func sessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func userSessionsKey(userID string) string {
+	return "sessions:" + userID
+}
+
+func familyKey(accessID string) string {
+	return "session:family:" + accessID
+}
+
+func RevokedKey(sessionID string) string {
+	return RevokedPrefix + sessionID
+}
+
+// Start ties an access token to the refresh token it came with and records
+// the session. Every access token minted from the same refresh token shares
+// one session ID, so revoking the session also stops the refresh token, and
+// a login shows up in List before its first authenticated request.
+func Start(ctx context.Context, rdb *redis.Client, accessToken, refreshToken, ip, userAgent string) error {
+	if accessToken == "" || refreshToken == "" {
+		return nil
+	}
+
+	claims := jwt.MapClaims{}
+	expiresAt := time.Now().Add(defaultTTL)
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, claims); err == nil {
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil && exp.After(time.Now()) {
+			expiresAt = exp.Time
+		}
+	}
+
+	sessionID := ID(refreshToken)
+	if err := rdb.Set(ctx, familyKey(ID(accessToken)), sessionID, time.Until(expiresAt)).Err(); err != nil {
+		return err
+	}
+
+	userID, _ := claims["user_id"].(string)
+	role, _ := claims["role"].(string)
+	if userID == "" {
+		return nil
+	}
+
+	return Touch(ctx, rdb, sessionID, userID, role, ip, userAgent, expiresAt)
+}
+
+// Resolve returns the session ID for an access token. Tokens that were never
+// bound, such as impersonation tokens, are their own session.
+func Resolve(ctx context.Context, rdb *redis.Client, accessToken string) (string, error) {
+	accessID := ID(accessToken)
+
+	familyMu.RLock()
+	family, ok := families[accessID]
+	familyMu.RUnlock()
+	if ok {
+		return family, nil
+	}
+
+	family, err := rdb.Get(ctx, familyKey(accessID)).Result()
+	if err == redis.Nil {
+		return accessID, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	familyMu.Lock()
+	if len(families) > 10000 {
+		families = make(map[string]string)
+	}
+	families[accessID] = family
+	familyMu.Unlock()
+
+	return family, nil
+}
+
+func ShouldTouch(sessionID string) bool {
+	touchMu.Lock()
+	defer touchMu.Unlock()
+
+	now := time.Now()
+	if last, ok := lastTouched[sessionID]; ok && now.Sub(last) < touchInterval {
+		return false
+	}
+
+	if len(lastTouched) > 10000 {
+		for id, last := range lastTouched {
+			if now.Sub(last) >= touchInterval {
+				delete(lastTouched, id)
+			}
+		}
+	}
+
+	lastTouched[sessionID] = now
+	return true
+}
+
+func Touch(ctx context.Context, rdb *redis.Client, sessionID, userID, role, ip, userAgent string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if expiresAt.IsZero() || ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	key := sessionKey(sessionID)
+
+	_, err := rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSetNX(ctx, key, "created_at", now)
+		pipe.HSet(ctx, key,
+			"user_id", userID,
+			"role", role,
+			"device", DeviceFromUserAgent(userAgent),
+			"ip", ip,
+			"user_agent", userAgent,
+			"last_seen", now,
+		)
+		pipe.Expire(ctx, key, ttl)
+		pipe.SAdd(ctx, userSessionsKey(userID), sessionID)
+		pipe.Expire(ctx, userSessionsKey(userID), defaultTTL*30)
+		return nil
+	})
+
+	return err
+}
+
+func List(ctx context.Context, rdb *redis.Client, userID, currentID string) ([]Session, error) {
+	ids, err := rdb.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]Session, 0, len(ids))
+	for _, id := range ids {
+		fields, err := rdb.HGetAll(ctx, sessionKey(id)).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) == 0 {
+			rdb.SRem(ctx, userSessionsKey(userID), id)
+			continue
+		}
+
+		sessions = append(sessions, Session{
+			ID:        id,
+			UserID:    fields["user_id"],
+			Role:      fields["role"],
+			Device:    fields["device"],
+			IP:        fields["ip"],
+			UserAgent: fields["user_agent"],
+			CreatedAt: fields["created_at"],
+			LastSeen:  fields["last_seen"],
+			Current:   id == currentID,
+		})
+	}
+
+	return sessions, nil
+}
+
+func Revoke(ctx context.Context, rdb *redis.Client, userID, sessionID string) error {
+	isMember, err := rdb.SIsMember(ctx, userSessionsKey(userID), sessionID).Result()
+	if err != nil {
+		return err
+	}
+
+	if !isMember {
+		return ErrSessionNotFound
+	}
+
+	return revoke(ctx, rdb, userID, sessionID)
+}
+
+func RevokeAll(ctx context.Context, rdb *redis.Client, userID string) (int, error) {
+	ids, err := rdb.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		if err := revoke(ctx, rdb, userID, id); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(ids), nil
+}
+
+// Remove ends the session behind token on logout, including the refresh
+// token it was issued with.
+func Remove(ctx context.Context, rdb *redis.Client, token string) error {
+	sessionID, err := Resolve(ctx, rdb, token)
+	if err != nil {
+		return err
+	}
+
+	userID, err := rdb.HGet(ctx, sessionKey(sessionID), "user_id").Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	return revoke(ctx, rdb, userID, sessionID)
+}
+
+func revoke(ctx context.Context, rdb *redis.Client, userID, sessionID string) error {
+	_, err := rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, RevokedKey(sessionID), userID, refreshTTL)
+		pipe.Del(ctx, sessionKey(sessionID))
+		if userID != "" {
+			pipe.SRem(ctx, userSessionsKey(userID), sessionID)
+		}
+		return nil
+	})
+
+	return err
+}
+
+func DeviceFromUserAgent(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	switch {
+	case ua == "":
+		return "Unknown"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		return "iOS"
+	case strings.Contains(ua, "android"):
+		return "Android"
+	case strings.Contains(ua, "windows"):
+		return "Windows"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"):
+		return "macOS"
+	case strings.Contains(ua, "linux"):
+		return "Linux"
+	case strings.Contains(ua, "postman"), strings.Contains(ua, "curl"):
+		return "API Client"
+	default:
+		return "Unknown"
+	}
+}
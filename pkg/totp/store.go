@@ -0,0 +1,218 @@
+package totp
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	pendingTTL  = 10 * time.Minute
+	attemptsTTL = 5 * time.Minute
+	maxAttempts = 5
+)
+
+var (
+	ErrNotEnrolled     = errors.New("two-factor authentication is not enabled")
+	ErrNoPending       = errors.New("no pending two-factor enrollment")
+	ErrInvalidCode     = errors.New("invalid verification code")
+	ErrTooManyAttempts = errors.New("too many verification attempts, try again later")
+	ErrNotConfigured   = errors.New("two-factor authentication is not configured")
+)
+
+type Store struct {
+	rdb *redis.Client
+	key []byte
+}
+
+func NewStore(rdb *redis.Client, encryptionKey string) *Store {
+	store := &Store{rdb: rdb}
+	if encryptionKey != "" {
+		sum := sha256.Sum256([]byte(encryptionKey))
+		store.key = sum[:]
+	}
+
+	return store
+}
+
+func secretKey(userID string) string {
+	return "totp:secret:" + userID
+}
+
+func pendingKey(userID string) string {
+	return "totp:pending:" + userID
+}
+
+func attemptsKey(userID string) string {
+	return "totp:attempts:" + userID
+}
+
+func usedKey(userID string, step int64) string {
+	return fmt.Sprintf("totp:used:%s:%d", userID, step)
+}
+
+func StepUpKey(sessionID string) string {
+	return "stepup:" + sessionID
+}
+
+func (s *Store) Enrolled(ctx context.Context, userID string) (bool, error) {
+	count, err := s.rdb.Exists(ctx, secretKey(userID)).Result()
+	return count > 0, err
+}
+
+func (s *Store) BeginEnrollment(ctx context.Context, userID string) (string, error) {
+	if s.key == nil {
+		return "", ErrNotConfigured
+	}
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := s.seal(secret)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.rdb.Set(ctx, pendingKey(userID), sealed, pendingTTL).Err(); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+func (s *Store) ConfirmEnrollment(ctx context.Context, userID, code string) error {
+	sealed, err := s.rdb.Get(ctx, pendingKey(userID)).Result()
+	if err == redis.Nil {
+		return ErrNoPending
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.check(ctx, userID, sealed, code); err != nil {
+		return err
+	}
+
+	_, err = s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, secretKey(userID), sealed, 0)
+		pipe.Del(ctx, pendingKey(userID))
+		return nil
+	})
+
+	return err
+}
+
+func (s *Store) Verify(ctx context.Context, userID, code string) error {
+	sealed, err := s.rdb.Get(ctx, secretKey(userID)).Result()
+	if err == redis.Nil {
+		return ErrNotEnrolled
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.check(ctx, userID, sealed, code)
+}
+
+func (s *Store) Disable(ctx context.Context, userID string) error {
+	return s.rdb.Del(ctx, secretKey(userID), pendingKey(userID)).Err()
+}
+
+func (s *Store) GrantStepUp(ctx context.Context, sessionID, userID string, ttl time.Duration) error {
+	return s.rdb.Set(ctx, StepUpKey(sessionID), userID, ttl).Err()
+}
+
+func (s *Store) check(ctx context.Context, userID, sealed, code string) error {
+	attempts, err := s.rdb.Incr(ctx, attemptsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+	if attempts == 1 {
+		s.rdb.Expire(ctx, attemptsKey(userID), attemptsTTL)
+	}
+	if attempts > maxAttempts {
+		return ErrTooManyAttempts
+	}
+
+	secret, err := s.open(sealed)
+	if err != nil {
+		return err
+	}
+
+	step, ok := Validate(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidCode
+	}
+
+	fresh, err := s.rdb.SetNX(ctx, usedKey(userID, step), 1, 3*period*time.Second).Result()
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return ErrInvalidCode
+	}
+
+	s.rdb.Del(ctx, attemptsKey(userID))
+	return nil
+}
+
+func (s *Store) seal(secret string) (string, error) {
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+func (s *Store) open(sealed string) (string, error) {
+	if s.key == nil {
+		return "", ErrNotConfigured
+	}
+
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("invalid sealed secret")
+	}
+
+	secret, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
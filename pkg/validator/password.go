@@ -0,0 +1,353 @@
+package validator
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/internals/constants"
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/config"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	ReasonTooShort      = "too_short"
+	ReasonTooLong       = "too_long"
+	ReasonMissingUpper  = "missing_uppercase"
+	ReasonMissingLower  = "missing_lowercase"
+	ReasonMissingDigit  = "missing_digit"
+	ReasonMissingSymbol = "missing_symbol"
+	ReasonBreached      = "breached"
+	ReasonSimilarEmail  = "similar_to_email"
+	ReasonReused        = "recently_used"
+)
+
+// bcrypt ignores everything past 72 bytes, so longer passwords would
+// silently weaken the reuse check.
+const maxPasswordLength = 72
+
+var Passwords = &PasswordPolicy{
+	MinLength:    constants.PasswordMinLength,
+	RequireUpper: true,
+	RequireLower: true,
+	RequireDigit: true,
+}
+
+type PasswordViolation struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, v.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	HistorySize   int
+
+	rdb      *redis.Client
+	breached map[string]struct{}
+	current  CurrentPasswordFunc
+}
+
+// CurrentPasswordFunc reports whether password is the user's current one.
+// The gateway only sees passwords as they change, so the current one may be
+// missing from the history.
+type CurrentPasswordFunc func(ctx context.Context, userID, password string) (bool, error)
+
+// CheckCurrentWith makes the reuse check also refuse the current password.
+func (p *PasswordPolicy) CheckCurrentWith(fn CurrentPasswordFunc) {
+	p.current = fn
+}
+
+func InitPasswordPolicy(cfg *config.Config, rdb *redis.Client) error {
+	policy := &PasswordPolicy{
+		MinLength:     config.GetInt(cfg.PASSWORD_MIN_LENGTH, constants.PasswordMinLength),
+		RequireUpper:  config.GetBool(cfg.PASSWORD_REQUIRE_UPPER, true),
+		RequireLower:  config.GetBool(cfg.PASSWORD_REQUIRE_LOWER, true),
+		RequireDigit:  config.GetBool(cfg.PASSWORD_REQUIRE_DIGIT, true),
+		RequireSymbol: config.GetBool(cfg.PASSWORD_REQUIRE_SYMBOL, false),
+		HistorySize:   config.GetInt(cfg.PASSWORD_HISTORY_SIZE, 5),
+		rdb:           rdb,
+	}
+
+	if cfg.PASSWORD_BREACHED_LIST != "" {
+		breached, err := loadBreachedHashes(cfg.PASSWORD_BREACHED_LIST)
+		if err != nil {
+			return err
+		}
+		policy.breached = breached
+	}
+
+	Passwords = policy
+	return nil
+}
+
+// loadBreachedHashes reads SHA-1 hashes one per line. The HIBP
+// "HASH:COUNT" download format is accepted as well.
+func loadBreachedHashes(path string) (map[string]struct{}, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	defer file.Close()
+
+	hashes := make(map[string]struct{})
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if idx := strings.IndexByte(line, ':'); idx >= 0 {
+			line = line[:idx]
+		}
+		if len(line) != sha1.Size*2 {
+			continue
+		}
+		hashes[strings.ToUpper(line)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password list: %w", err)
+	}
+
+	return hashes, nil
+}
+
+func historyKey(userID string) string {
+	return "pwhistory:" + userID
+}
+
+// registrationKey holds the password an account signed up with, which is
+// recorded before the account has an ID.
+func registrationKey(email string) string {
+	return "pwhistory:email:" + strings.ToLower(email)
+}
+
+// Check validates password against the policy. email and userID are optional;
+// email enables the similarity check and userID the reuse check, which
+// covers the current password, the recorded history and the password the
+// account signed up with.
+func (p *PasswordPolicy) Check(ctx context.Context, password, email, userID string) error {
+	var violations []PasswordViolation
+	add := func(code, message string) {
+		violations = append(violations, PasswordViolation{Code: code, Message: message})
+	}
+
+	if len(password) < p.MinLength {
+		add(ReasonTooShort, fmt.Sprintf("password must be at least %d characters long", p.MinLength))
+	}
+
+	if len(password) > maxPasswordLength {
+		add(ReasonTooLong, fmt.Sprintf("password must be at most %d characters long", maxPasswordLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r), unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUpper && !hasUpper {
+		add(ReasonMissingUpper, "password must contain an uppercase letter")
+	}
+	if p.RequireLower && !hasLower {
+		add(ReasonMissingLower, "password must contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		add(ReasonMissingDigit, "password must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		add(ReasonMissingSymbol, "password must contain a symbol")
+	}
+
+	if p.isBreached(password) {
+		add(ReasonBreached, "password has appeared in a data breach, choose a different one")
+	}
+
+	if email != "" && similarToEmail(password, email) {
+		add(ReasonSimilarEmail, "password is too similar to your email address")
+	}
+
+	if userID != "" && len(violations) == 0 {
+		reused, err := p.recentlyUsed(ctx, userID, email, password)
+		if err != nil {
+			return err
+		}
+		if reused {
+			add(ReasonReused, fmt.Sprintf("password must not match any of your last %d passwords", p.HistorySize))
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+// Remember records password in the user's history so it cannot be reused.
+// The history is best effort: the password has already changed by the time
+// it is recorded, so callers log a failure instead of failing the request,
+// and the current password is still refused through CheckCurrentWith.
+func (p *PasswordPolicy) Remember(ctx context.Context, userID, password string) error {
+	if userID == "" {
+		return nil
+	}
+
+	return p.remember(ctx, historyKey(userID), password)
+}
+
+// RememberRegistration records the password an account signs up with. It
+// is best effort in the same way as Remember.
+func (p *PasswordPolicy) RememberRegistration(ctx context.Context, email, password string) error {
+	if email == "" {
+		return nil
+	}
+
+	return p.remember(ctx, registrationKey(email), password)
+}
+
+func (p *PasswordPolicy) remember(ctx context.Context, key, password string) error {
+	if p.rdb == nil || p.HistorySize <= 0 {
+		return nil
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, hash)
+		pipe.LTrim(ctx, key, 0, int64(p.HistorySize-1))
+		return nil
+	})
+
+	return err
+}
+
+func (p *PasswordPolicy) isBreached(password string) bool {
+	if len(p.breached) == 0 {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password))
+	_, found := p.breached[strings.ToUpper(hex.EncodeToString(sum[:]))]
+	return found
+}
+
+func (p *PasswordPolicy) recentlyUsed(ctx context.Context, userID, email, password string) (bool, error) {
+	if p.HistorySize <= 0 {
+		return false, nil
+	}
+
+	if p.current != nil {
+		current, err := p.current(ctx, userID, password)
+		if err != nil || current {
+			return current, err
+		}
+	}
+
+	if p.rdb == nil {
+		return false, nil
+	}
+
+	keys := []string{historyKey(userID)}
+	if email != "" {
+		keys = append(keys, registrationKey(email))
+	}
+
+	for _, key := range keys {
+		hashes, err := p.rdb.LRange(ctx, key, 0, int64(p.HistorySize-1)).Result()
+		if err != nil {
+			return false, err
+		}
+
+		for _, hash := range hashes {
+			if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func similarToEmail(password, email string) bool {
+	local := strings.ToLower(email)
+	if idx := strings.IndexByte(local, '@'); idx >= 0 {
+		local = local[:idx]
+	}
+	local = alphanumeric(local)
+	candidate := alphanumeric(strings.ToLower(password))
+
+	if len(local) < 4 || candidate == "" {
+		return false
+	}
+
+	if strings.Contains(candidate, local) || strings.Contains(local, candidate) {
+		return true
+	}
+
+	return levenshtein(candidate, local) <= 2
+}
+
+func alphanumeric(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
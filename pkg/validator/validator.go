@@ -21,10 +21,6 @@ func ValidateSignup(req models.RegisterRequestBody) error {
 		return errors.New("invalid email format")
 	}
 
-	if len(req.Password) < constants.PasswordMinLength {
-		return errors.New("password must be at least 8 characters long")
-	}
-
 	if req.Role != "vendor" && req.Role != "client" {
 		return errors.New("invalid role")
 	}
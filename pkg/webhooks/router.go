@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	BackendClient = "client"
+	BackendVendor = "vendor"
+	BackendAdmin  = "admin"
+)
+
+// Route sends the listed event types of one provider to a backend RPC,
+// named by its method (e.g. HandleStripeEvent). Types match exactly, by
+// "prefix.*", or "*" for everything.
+type Route struct {
+	Provider string   `json:"provider"`
+	Types    []string `json:"types"`
+	Backend  string   `json:"backend"`
+	Method   string   `json:"method"`
+}
+
+type Target struct {
+	Backend string `json:"backend"`
+	Method  string `json:"method"`
+}
+
+func (t Target) String() string {
+	return t.Backend + "/" + t.Method
+}
+
+// Router doubles as the allowlist: an event type no route matches is
+// dropped at intake and never stored.
+type Router struct {
+	routes []Route
+}
+
+// NewRouter uses routesJSON (WEBHOOK_ROUTES) when set and the defaults
+// otherwise.
+func NewRouter(routesJSON string, defaults []Route) (*Router, error) {
+	routes := defaults
+	if strings.TrimSpace(routesJSON) != "" {
+		// Decoding into a fresh slice keeps fields of the defaults from
+		// leaking into configured routes that omit them.
+		var configured []Route
+		if err := json.Unmarshal([]byte(routesJSON), &configured); err != nil {
+			return nil, fmt.Errorf("invalid WEBHOOK_ROUTES: %w", err)
+		}
+		routes = configured
+	}
+
+	for _, route := range routes {
+		if route.Provider == "" || route.Backend == "" || route.Method == "" || len(route.Types) == 0 {
+			return nil, fmt.Errorf("webhook route %+v needs provider, types, backend and method", route)
+		}
+		switch route.Backend {
+		case BackendClient, BackendVendor, BackendAdmin:
+		default:
+			return nil, fmt.Errorf("webhook route for %s has unknown backend %q", route.Provider, route.Backend)
+		}
+	}
+
+	return &Router{routes: routes}, nil
+}
+
+func (r *Router) Match(provider, eventType string) []Target {
+	var targets []Target
+	seen := map[Target]bool{}
+
+	for _, route := range r.routes {
+		if !strings.EqualFold(route.Provider, provider) || !matchesType(route.Types, eventType) {
+			continue
+		}
+
+		target := Target{Backend: route.Backend, Method: route.Method}
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
+
+// Targets lists every distinct target the routes name.
+func (r *Router) Targets() []Target {
+	var targets []Target
+	seen := map[Target]bool{}
+
+	for _, route := range r.routes {
+		target := Target{Backend: route.Backend, Method: route.Method}
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	return targets
+}
+
+func (r *Router) Allowed(provider, eventType string) bool {
+	return len(r.Match(provider, eventType)) > 0
+}
+
+func matchesType(patterns []string, eventType string) bool {
+	for _, pattern := range patterns {
+		switch {
+		case pattern == "*" || pattern == eventType:
+			return true
+		case strings.HasSuffix(pattern, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(pattern, "*")):
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+package webhooks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRouterMatch(t *testing.T) {
+	router, err := NewRouter("", []Route{
+		{Provider: "stripe", Types: []string{"checkout.session.*", "charge.refunded"}, Backend: BackendClient, Method: "HandleStripeEvent"},
+		{Provider: "stripe", Types: []string{"payout.*"}, Backend: BackendVendor, Method: "HandleStripeEvent"},
+		{Provider: "stripe", Types: []string{"payout.*", "transfer.*"}, Backend: BackendAdmin, Method: "HandleStripeEvent"},
+		{Provider: "stripe", Types: []string{"transfer.created"}, Backend: BackendAdmin, Method: "HandleStripeEvent"},
+		{Provider: "razorpay", Types: []string{"*"}, Backend: BackendClient, Method: "HandleRazorpayEvent"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := Target{Backend: BackendClient, Method: "HandleStripeEvent"}
+	vendor := Target{Backend: BackendVendor, Method: "HandleStripeEvent"}
+	admin := Target{Backend: BackendAdmin, Method: "HandleStripeEvent"}
+	razorpay := Target{Backend: BackendClient, Method: "HandleRazorpayEvent"}
+
+	tests := []struct {
+		name      string
+		provider  string
+		eventType string
+		want      []Target
+	}{
+		{name: "prefix", provider: "stripe", eventType: "checkout.session.completed", want: []Target{client}},
+		{name: "exact", provider: "stripe", eventType: "charge.refunded", want: []Target{client}},
+		{name: "exact does not match prefix", provider: "stripe", eventType: "charge.refunded.extra"},
+		{name: "prefix needs the dot", provider: "stripe", eventType: "checkout.sessionx"},
+		{name: "fan out in route order", provider: "stripe", eventType: "payout.paid", want: []Target{vendor, admin}},
+		{name: "duplicates collapse", provider: "stripe", eventType: "transfer.created", want: []Target{admin}},
+		{name: "provider is case insensitive", provider: "Stripe", eventType: "payout.failed", want: []Target{vendor, admin}},
+		{name: "wildcard", provider: "razorpay", eventType: "order.paid", want: []Target{razorpay}},
+		{name: "unrouted type", provider: "stripe", eventType: "customer.created"},
+		{name: "unknown provider", provider: "paypal", eventType: "payout.paid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := router.Match(tt.provider, tt.eventType)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.provider, tt.eventType, got, tt.want)
+			}
+			if allowed := router.Allowed(tt.provider, tt.eventType); allowed != (len(tt.want) > 0) {
+				t.Errorf("Allowed(%q, %q) = %v", tt.provider, tt.eventType, allowed)
+			}
+		})
+	}
+}
+
+func TestNewRouter(t *testing.T) {
+	defaults := []Route{{Provider: "stripe", Types: []string{"*"}, Backend: BackendClient, Method: "HandleStripeEvent"}}
+
+	tests := []struct {
+		name    string
+		routes  string
+		want    []Target
+		wantErr bool
+	}{
+		{name: "defaults", want: []Target{{Backend: BackendClient, Method: "HandleStripeEvent"}}},
+		{name: "configured routes replace defaults", routes: `[{"provider":"stripe","types":["*"],"backend":"admin","method":"HandleStripeEvent"}]`, want: []Target{{Backend: BackendAdmin, Method: "HandleStripeEvent"}}},
+		{name: "malformed", routes: `{`, wantErr: true},
+		{name: "missing method", routes: `[{"provider":"stripe","types":["*"],"backend":"admin"}]`, wantErr: true},
+		{name: "missing types", routes: `[{"provider":"stripe","backend":"admin","method":"HandleStripeEvent"}]`, wantErr: true},
+		{name: "unknown backend", routes: `[{"provider":"stripe","types":["*"],"backend":"auth","method":"HandleStripeEvent"}]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := NewRouter(tt.routes, defaults)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRouter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(router.Targets(), tt.want) {
+				t.Errorf("Targets() = %v, want %v", router.Targets(), tt.want)
+			}
+		})
+	}
+}
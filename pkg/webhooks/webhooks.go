@@ -0,0 +1,429 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AthulKrishna2501/zyra-api-gateway/pkg/payment"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	streamKey   = "webhooks:stream"
+	retryKey    = "webhooks:retry"
+	failedKey   = "webhooks:failed"
+	eventPrefix = "webhooks:event:"
+	group       = "gateway"
+
+	eventTTL    = 7 * 24 * time.Hour
+	maxAttempts = 10
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+
+	forwardTimeout = 30 * time.Second
+	// deliverTimeout bounds one delivery across all of its targets.
+	deliverTimeout = 2 * time.Minute
+	// claimIdle must exceed deliverTimeout so an entry is only taken over
+	// once its consumer has stopped working on it.
+	claimIdle = deliverTimeout + time.Minute
+)
+
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+	StatusDropped   = "dropped"
+)
+
+var ErrNotFound = errors.New("webhook event not found")
+
+type Record struct {
+	Key         string   `json:"key"`
+	Provider    string   `json:"provider"`
+	EventID     string   `json:"event_id"`
+	Type        string   `json:"type"`
+	Payload     string   `json:"payload"`
+	Status      string   `json:"status"`
+	Attempts    int      `json:"attempts"`
+	DeliveredTo []string `json:"delivered_to,omitempty"`
+	LastError   string   `json:"last_error,omitempty"`
+	ReceivedAt  string   `json:"received_at"`
+	DeliveredAt string   `json:"delivered_at,omitempty"`
+}
+
+type Forwarder func(ctx context.Context, target Target, event *payment.Event) error
+
+func eventKey(key string) string {
+	return eventPrefix + key
+}
+
+// Key identifies an event by provider and provider event id. Events without
+// an id fall back to a hash of the payload.
+func Key(event *payment.Event) string {
+	id := event.ID
+	if id == "" {
+		sum := sha256.Sum256(event.Payload)
+		id = "sha256-" + hex.EncodeToString(sum[:16])
+	}
+
+	return event.Provider + ":" + id
+}
+
+// Enqueue persists a verified event and queues it for delivery. It reports
+// duplicate when the same provider event was already accepted.
+func Enqueue(ctx context.Context, rdb *redis.Client, event *payment.Event) (string, bool, error) {
+	record := Record{
+		Key:        Key(event),
+		Provider:   event.Provider,
+		EventID:    event.ID,
+		Type:       event.Type,
+		Payload:    string(event.Payload),
+		Status:     StatusPending,
+		ReceivedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", false, err
+	}
+
+	// The record and its stream entry are written in one transaction so an
+	// accepted event is never left without a delivery.
+	duplicate := false
+	err = rdb.Watch(ctx, func(tx *redis.Tx) error {
+		exists, err := tx.Exists(ctx, eventKey(record.Key)).Result()
+		if err != nil {
+			return err
+		}
+		if exists > 0 {
+			duplicate = true
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.SetNX(ctx, eventKey(record.Key), data, eventTTL)
+			pipe.XAdd(ctx, &redis.XAddArgs{Stream: streamKey, Values: map[string]any{"key": record.Key}})
+			return nil
+		})
+		return err
+	}, eventKey(record.Key))
+	if errors.Is(err, redis.TxFailedErr) {
+		// Another request stored the same event between the check and the
+		// transaction.
+		return record.Key, true, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	return record.Key, duplicate, nil
+}
+
+func Get(ctx context.Context, rdb *redis.Client, key string) (*Record, error) {
+	data, err := rdb.Get(ctx, eventKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record Record
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// Failed lists events that exhausted their retries, newest first.
+func Failed(ctx context.Context, rdb *redis.Client, limit int64) ([]Record, error) {
+	keys, err := rdb.ZRevRange(ctx, failedKey, 0, limit-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Record, 0, len(keys))
+	for _, key := range keys {
+		record, err := Get(ctx, rdb, key)
+		if errors.Is(err, ErrNotFound) {
+			rdb.ZRem(ctx, failedKey, key)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// Replay puts a failed or delivered event back on the stream with a fresh
+// attempt budget.
+func Replay(ctx context.Context, rdb *redis.Client, key string) (*Record, error) {
+	record, err := Get(ctx, rdb, key)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Status = StatusPending
+	record.Attempts = 0
+	record.DeliveredTo = nil
+	record.LastError = ""
+	if err := save(ctx, rdb, record); err != nil {
+		return nil, err
+	}
+
+	rdb.ZRem(ctx, failedKey, key)
+	rdb.ZRem(ctx, retryKey, key)
+	if err := rdb.XAdd(ctx, &redis.XAddArgs{Stream: streamKey, Values: map[string]any{"key": key}}).Err(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func save(ctx context.Context, rdb *redis.Client, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return rdb.Set(ctx, eventKey(record.Key), data, eventTTL).Err()
+}
+
+func backoff(attempt int) time.Duration {
+	delay := baseBackoff << (attempt - 1)
+	if delay <= 0 || delay > maxBackoff {
+		return maxBackoff
+	}
+
+	return delay
+}
+
+type Worker struct {
+	rdb      *redis.Client
+	router   *Router
+	forward  Forwarder
+	consumer string
+}
+
+func NewWorker(rdb *redis.Client, router *Router, forward Forwarder) *Worker {
+	host, _ := os.Hostname()
+	return &Worker{rdb: rdb, router: router, forward: forward, consumer: host + "-" + strconv.Itoa(os.Getpid())}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	err := w.rdb.XGroupCreateMkStream(ctx, streamKey, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		log.Println("Webhooks: failed to create consumer group:", err)
+	}
+
+	go w.consume(ctx)
+	go w.retryLoop(ctx)
+}
+
+func (w *Worker) consume(ctx context.Context) {
+	for ctx.Err() == nil {
+		// Pick up entries another gateway instance read but never acked.
+		claimed, _, err := w.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   streamKey,
+			Group:    group,
+			Consumer: w.consumer,
+			MinIdle:  claimIdle,
+			Start:    "0",
+			Count:    10,
+		}).Result()
+		if err == nil {
+			w.handle(ctx, claimed)
+		}
+
+		streams, err := w.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: w.consumer,
+			Streams:  []string{streamKey, ">"},
+			Count:    10,
+			Block:    5 * time.Second,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Println("Webhooks: failed to read stream:", err)
+				time.Sleep(time.Second)
+			}
+			continue
+		}
+
+		for _, stream := range streams {
+			w.handle(ctx, stream.Messages)
+		}
+	}
+}
+
+// handle acks an entry only once its event is delivered, dropped or
+// scheduled for retry. Anything else stays pending for XAutoClaim.
+func (w *Worker) handle(ctx context.Context, messages []redis.XMessage) {
+	for _, msg := range messages {
+		// Claiming our own entry resets its idle time, so entries later in
+		// the batch are not taken over while earlier ones are delivered.
+		if err := w.rdb.XClaimJustID(ctx, &redis.XClaimArgs{
+			Stream:   streamKey,
+			Group:    group,
+			Consumer: w.consumer,
+			Messages: []string{msg.ID},
+		}).Err(); err != nil {
+			continue
+		}
+
+		if key, ok := msg.Values["key"].(string); ok && !w.deliver(ctx, key) {
+			continue
+		}
+
+		w.rdb.XAck(ctx, streamKey, group, msg.ID)
+		w.rdb.XDel(ctx, streamKey, msg.ID)
+	}
+}
+
+func (w *Worker) retryLoop(ctx context.Context) {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		due, err := w.rdb.ZRangeByScore(ctx, retryKey, &redis.ZRangeBy{
+			Min: "-inf",
+			Max: strconv.FormatInt(time.Now().Unix(), 10),
+		}).Result()
+		if err != nil {
+			log.Println("Webhooks: failed to read retry queue:", err)
+			continue
+		}
+
+		for _, key := range due {
+			// ZREM acts as the claim so only one gateway instance retries an event.
+			claimed, err := w.rdb.ZRem(ctx, retryKey, key).Result()
+			if err != nil || claimed == 0 {
+				continue
+			}
+
+			if !w.deliver(ctx, key) {
+				w.rdb.ZAdd(ctx, retryKey, redis.Z{Score: float64(time.Now().Add(baseBackoff).Unix()), Member: key})
+			}
+		}
+	}
+}
+
+// deliver reports whether the outcome was stored, so the caller can let go
+// of the event.
+func (w *Worker) deliver(ctx context.Context, key string) bool {
+	record, err := Get(ctx, w.rdb, key)
+	if errors.Is(err, ErrNotFound) {
+		log.Printf("Webhooks: %s expired before delivery", key)
+		return true
+	}
+	if err != nil {
+		log.Printf("Webhooks: failed to load %s: %v", key, err)
+		return false
+	}
+	if record.Status == StatusDelivered {
+		return true
+	}
+
+	targets := w.router.Match(record.Provider, record.Type)
+	if len(targets) == 0 {
+		record.Status = StatusDropped
+		log.Printf("Webhooks: no route for %s event %s, dropping %s", record.Provider, record.Type, key)
+		return w.save(ctx, record)
+	}
+
+	event := &payment.Event{
+		Provider: record.Provider,
+		ID:       record.EventID,
+		Type:     record.Type,
+		Payload:  []byte(record.Payload),
+	}
+
+	deliverCtx, cancel := context.WithTimeout(ctx, deliverTimeout)
+	defer cancel()
+
+	// Targets that already accepted the event are skipped on retries so a
+	// failing backend does not cause duplicates at the healthy ones.
+	var errs []error
+	for _, target := range targets {
+		if slices.Contains(record.DeliveredTo, target.String()) {
+			continue
+		}
+
+		forwardCtx, cancel := context.WithTimeout(deliverCtx, forwardTimeout)
+		err := w.forward(forwardCtx, target, event)
+		cancel()
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target.Backend, err))
+			continue
+		}
+		record.DeliveredTo = append(record.DeliveredTo, target.String())
+	}
+	err = errors.Join(errs...)
+
+	record.Attempts++
+	if err == nil {
+		record.Status = StatusDelivered
+		record.LastError = ""
+		record.DeliveredAt = time.Now().UTC().Format(time.RFC3339)
+		return w.save(ctx, record)
+	}
+
+	record.LastError = err.Error()
+	if record.Attempts >= maxAttempts {
+		record.Status = StatusFailed
+		log.Printf("Webhooks: giving up on %s after %d attempts: %v", key, record.Attempts, err)
+		if !w.save(ctx, record) {
+			return false
+		}
+		if err := w.rdb.ZAdd(ctx, failedKey, redis.Z{Score: float64(time.Now().Unix()), Member: key}).Err(); err != nil {
+			log.Printf("Webhooks: failed to mark %s failed: %v", key, err)
+			return false
+		}
+		return true
+	}
+
+	delay := backoff(record.Attempts)
+	log.Printf("Webhooks: delivery of %s failed (attempt %d), retrying in %s: %v", key, record.Attempts, delay, err)
+	if !w.save(ctx, record) {
+		return false
+	}
+	if err := w.rdb.ZAdd(ctx, retryKey, redis.Z{Score: float64(time.Now().Add(delay).Unix()), Member: key}).Err(); err != nil {
+		log.Printf("Webhooks: failed to schedule retry of %s: %v", key, err)
+		return false
+	}
+
+	return true
+}
+
+func (w *Worker) save(ctx context.Context, record *Record) bool {
+	if err := save(ctx, w.rdb, record); err != nil {
+		log.Printf("Webhooks: failed to save %s: %v", record.Key, err)
+		return false
+	}
+
+	return true
+}